@@ -16,10 +16,12 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 
 	"github.com/lovoo/protoc-gen-go-grpcmock/internal/framework"
@@ -39,15 +41,48 @@ func main() {
 
 	var flags flag.FlagSet
 	testFramework := flags.String("framework", "testify", "The mocking framework to use.")
+	target := flags.String("target", framework.TargetGRPC, "The RPC stack to generate mocks for.")
 	importPackage := flags.Bool("import_package", false, "Import the file's Go package.")
+	gomega := flags.Bool("gomega", false, "Generate Gomega matchers for all messages and methods.")
+	testingTB := flags.Bool("testing_tb", false, "Generate constructors of the mocks, which accept a testing.TB.")
+	lenient := flags.Bool("lenient", false, "Return codes.Unimplemented for unstubbed calls of the testify mocks instead of panicking.")
+	suite := flags.Bool("suite", false, "Generate a testify suite for each service.")
+	layout := flags.String("layout", generator.LayoutFile, "The layout of the generated files: one file per .proto file or one file per Go package.")
+	testFile := flags.Bool("test_file", false, "Generate the mocks to _test.go files, which are only compiled by go test.")
+	buildConstraint := flags.String("build_constraint", "", "The //go:build constraint of the generated files.")
+	configFile := flags.String("config", "", "The YAML file overriding the options per package, service and method.")
+	examples := flags.Bool("examples", false, "Generate runnable examples of the client mocks to a _grpc_mock_example_test.go file.")
+	check := flags.Bool("check", false, "Compare the generated files with the existing files instead of writing them.")
+	checkDir := flags.String("check_dir", ".", "The directory containing the existing files, usually the output directory.")
 	protogen.Options{ParamFunc: flags.Set}.Run(func(gen *protogen.Plugin) error {
-		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+		gen.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+		gen.SupportedEditionsMinimum = descriptorpb.Edition_EDITION_PROTO2
+		gen.SupportedEditionsMaximum = descriptorpb.Edition_EDITION_2023
 
-		m, err := framework.Mocker(*testFramework)
+		opts := framework.Options{
+			Target:    *target,
+			Gomega:    *gomega,
+			TestingTB: *testingTB,
+			Lenient:   *lenient,
+			Suite:     *suite,
+		}
+		m, err := framework.Mocker(*testFramework, opts)
+		if err != nil {
+			return err
+		}
+		build := generator.Build{TestFile: *testFile, Constraint: *buildConstraint}
+		if err := build.Validate(); err != nil {
+			return err
+		}
+		config, err := generator.LoadConfig(*configFile)
 		if err != nil {
 			return err
 		}
+		if err := errors.Join(config.Validate(gen.Files), framework.ValidateConfig(*testFramework, opts, config)); err != nil {
+			return fmt.Errorf("protoc-gen-go-grpcmock: config %s: %w", *configFile, err)
+		}
 
+		var files []*protogen.File
 		for _, f := range gen.Files {
 			if !f.Generate {
 				continue
@@ -57,10 +92,54 @@ func main() {
 				// Force import of the package.
 				f.GoImportPath = protogen.GoImportPath("")
 			}
+			files = append(files, f)
+		}
 
-			generator.GenerateFile(version, gen, f, m)
+		switch *layout {
+		case generator.LayoutFile:
+		case generator.LayoutPackage:
+			return generatePackages(gen, files, m, build, config, *examples, *check, *checkDir)
+		default:
+			return fmt.Errorf("protoc-gen-go-grpcmock: unknown layout %q. Please use one of the following: [%q, %q]", *layout, generator.LayoutFile, generator.LayoutPackage)
 		}
 
-		return nil
+		var errs []error
+		for _, f := range files {
+			g := generator.GenerateFile(version, gen, f, m, build, config)
+			if *check && g != nil {
+				errs = append(errs, generator.CheckFile(*checkDir, build.Filename(generator.FileOutput(f, m).Filename), g))
+			}
+
+			if !*examples {
+				continue
+			}
+			g = generator.GenerateExampleFile(version, gen, f, m, build, config)
+			if *check && g != nil {
+				errs = append(errs, generator.CheckFile(*checkDir, generator.ExampleFilename(f, m), g))
+			}
+		}
+
+		return errors.Join(errs...)
 	})
 }
+
+// generatePackages generates the mocks of all files of each Go package to a single file.
+func generatePackages(gen *protogen.Plugin, files []*protogen.File, m generator.Mocker, build generator.Build, config *generator.Config, examples, check bool, checkDir string) error {
+	var errs []error
+	for _, pkg := range generator.Packages(files, m) {
+		g := generator.GeneratePackageFile(version, gen, pkg, m, build, config)
+		if check && g != nil {
+			errs = append(errs, generator.CheckFile(checkDir, build.Filename(pkg.Filename), g))
+		}
+
+		if !examples {
+			continue
+		}
+		g = generator.GeneratePackageExampleFile(version, gen, pkg, m, build, config)
+		if check && g != nil {
+			errs = append(errs, generator.CheckFile(checkDir, pkg.ExampleFilename(), g))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,934 @@
+// Code generated by protoc-gen-go-grpcmock. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpcmock v1.3.0
+// - protoc                 v5.27.0
+// - pegomock               v2.9.0+incompatible
+// source: editions.proto
+
+package editions
+
+import (
+	context "context"
+	grpcmock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+	gomegamock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/gomegamock"
+	pegomockmock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/pegomockmock"
+	types "github.com/onsi/gomega/types"
+	pegomock "github.com/petergtz/pegomock"
+	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	time "time"
+)
+
+type MockInventoryClient struct {
+	fail        func(message string, callerSkip ...int)
+	invocations pegomockmock.Invocations
+}
+
+func NewMockInventoryClient(options ...pegomock.Option) *MockInventoryClient {
+	mock := &MockInventoryClient{}
+	pegomockmock.TrackCoverage("editions.Inventory", []string{"GetItem", "WatchItems", "ImportItems"}, mock, &mock.invocations)
+	for _, option := range options {
+		option.Apply(mock)
+	}
+	return mock
+}
+
+func (mock *MockInventoryClient) SetFailHandler(fh pegomock.FailHandler) { mock.fail = fh }
+func (mock *MockInventoryClient) FailHandler() pegomock.FailHandler      { return mock.fail }
+
+func (mock *MockInventoryClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*Item, error) {
+	if mock == nil {
+		panic("mock must not be nil. Use myMock := NewMockInventoryClient().")
+	}
+	params := []pegomock.Param{ctx, in}
+	for _, param := range opts {
+		params = append(params, param)
+	}
+	mock.invocations.Invoked("GetItem", params)
+	result := pegomock.GetGenericMockFrom(mock).Invoke("GetItem", params, []reflect.Type{reflect.TypeOf((**Item)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
+	var ret0 *Item
+	var ret1 error
+	if len(result) != 0 {
+		if result[0] != nil {
+			ret0 = result[0].(*Item)
+		}
+		if result[1] != nil {
+			ret1 = result[1].(error)
+		}
+	}
+	return ret0, ret1
+}
+
+func (mock *MockInventoryClient) WatchItems(ctx context.Context, in *WatchItemsRequest, opts ...grpc.CallOption) (Inventory_WatchItemsClient, error) {
+	if mock == nil {
+		panic("mock must not be nil. Use myMock := NewMockInventoryClient().")
+	}
+	params := []pegomock.Param{ctx, in}
+	for _, param := range opts {
+		params = append(params, param)
+	}
+	mock.invocations.Invoked("WatchItems", params)
+	result := pegomock.GetGenericMockFrom(mock).Invoke("WatchItems", params, []reflect.Type{reflect.TypeOf((*Inventory_WatchItemsClient)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
+	var ret0 Inventory_WatchItemsClient
+	var ret1 error
+	if len(result) != 0 {
+		if result[0] != nil {
+			ret0 = result[0].(Inventory_WatchItemsClient)
+		}
+		if result[1] != nil {
+			ret1 = result[1].(error)
+		}
+	}
+	return ret0, ret1
+}
+
+func (mock *MockInventoryClient) ImportItems(ctx context.Context, opts ...grpc.CallOption) (Inventory_ImportItemsClient, error) {
+	if mock == nil {
+		panic("mock must not be nil. Use myMock := NewMockInventoryClient().")
+	}
+	params := []pegomock.Param{ctx}
+	for _, param := range opts {
+		params = append(params, param)
+	}
+	mock.invocations.Invoked("ImportItems", params)
+	result := pegomock.GetGenericMockFrom(mock).Invoke("ImportItems", params, []reflect.Type{reflect.TypeOf((*Inventory_ImportItemsClient)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
+	var ret0 Inventory_ImportItemsClient
+	var ret1 error
+	if len(result) != 0 {
+		if result[0] != nil {
+			ret0 = result[0].(Inventory_ImportItemsClient)
+		}
+		if result[1] != nil {
+			ret1 = result[1].(error)
+		}
+	}
+	return ret0, ret1
+}
+
+func (mock *MockInventoryClient) VerifyWasCalledOnce() *VerifierMockInventoryClient {
+	return &VerifierMockInventoryClient{
+		mock:                   mock,
+		invocationCountMatcher: pegomock.Times(1),
+	}
+}
+
+func (mock *MockInventoryClient) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *VerifierMockInventoryClient {
+	return &VerifierMockInventoryClient{
+		mock:                   mock,
+		invocationCountMatcher: invocationCountMatcher,
+	}
+}
+
+func (mock *MockInventoryClient) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *VerifierMockInventoryClient {
+	return &VerifierMockInventoryClient{
+		mock:                   mock,
+		invocationCountMatcher: invocationCountMatcher,
+		inOrderContext:         inOrderContext,
+	}
+}
+
+func (mock *MockInventoryClient) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *VerifierMockInventoryClient {
+	return &VerifierMockInventoryClient{
+		mock:                   mock,
+		invocationCountMatcher: invocationCountMatcher,
+		timeout:                timeout,
+	}
+}
+
+type VerifierMockInventoryClient struct {
+	mock                   *MockInventoryClient
+	invocationCountMatcher pegomock.InvocationCountMatcher
+	inOrderContext         *pegomock.InOrderContext
+	timeout                time.Duration
+}
+
+func (verifier *VerifierMockInventoryClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) *MockInventoryClient_GetItem_OngoingVerification {
+	params := []pegomock.Param{ctx, in}
+	for _, param := range opts {
+		params = append(params, param)
+	}
+	pegomockmock.ResetMatchers()
+	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "GetItem", params, verifier.timeout)
+	return &MockInventoryClient_GetItem_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+}
+
+type MockInventoryClient_GetItem_OngoingVerification struct {
+	mock              *MockInventoryClient
+	methodInvocations []pegomock.MethodInvocation
+}
+
+func (c *MockInventoryClient_GetItem_OngoingVerification) GetCapturedArguments() (context.Context, *GetItemRequest, []grpc.CallOption) {
+	ctx, in, opts := c.GetAllCapturedArguments()
+	return ctx[len(ctx)-1], in[len(in)-1], opts[len(opts)-1]
+}
+
+func (c *MockInventoryClient_GetItem_OngoingVerification) GetAllCapturedArguments() (_param0 []context.Context, _param1 []*GetItemRequest, _param2 [][]grpc.CallOption) {
+	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
+	if len(params) > 0 {
+		_param0 = make([]context.Context, len(c.methodInvocations))
+		for u, param := range params[0] {
+			_param0[u] = param.(context.Context)
+		}
+		_param1 = make([]*GetItemRequest, len(c.methodInvocations))
+		for u, param := range params[1] {
+			_param1[u] = param.(*GetItemRequest)
+		}
+		_param2 = make([][]grpc.CallOption, len(c.methodInvocations))
+		for u := 0; u < len(c.methodInvocations); u++ {
+			_param2[u] = make([]grpc.CallOption, len(params)-2)
+			for x := 2; x < len(params); x++ {
+				if params[x][u] != nil {
+					_param2[u][x-2] = params[x][u].(grpc.CallOption)
+				}
+			}
+		}
+	}
+	return
+}
+
+func (verifier *VerifierMockInventoryClient) WatchItems(ctx context.Context, in *WatchItemsRequest, opts ...grpc.CallOption) *MockInventoryClient_WatchItems_OngoingVerification {
+	params := []pegomock.Param{ctx, in}
+	for _, param := range opts {
+		params = append(params, param)
+	}
+	pegomockmock.ResetMatchers()
+	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "WatchItems", params, verifier.timeout)
+	return &MockInventoryClient_WatchItems_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+}
+
+type MockInventoryClient_WatchItems_OngoingVerification struct {
+	mock              *MockInventoryClient
+	methodInvocations []pegomock.MethodInvocation
+}
+
+func (c *MockInventoryClient_WatchItems_OngoingVerification) GetCapturedArguments() (context.Context, *WatchItemsRequest, []grpc.CallOption) {
+	ctx, in, opts := c.GetAllCapturedArguments()
+	return ctx[len(ctx)-1], in[len(in)-1], opts[len(opts)-1]
+}
+
+func (c *MockInventoryClient_WatchItems_OngoingVerification) GetAllCapturedArguments() (_param0 []context.Context, _param1 []*WatchItemsRequest, _param2 [][]grpc.CallOption) {
+	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
+	if len(params) > 0 {
+		_param0 = make([]context.Context, len(c.methodInvocations))
+		for u, param := range params[0] {
+			_param0[u] = param.(context.Context)
+		}
+		_param1 = make([]*WatchItemsRequest, len(c.methodInvocations))
+		for u, param := range params[1] {
+			_param1[u] = param.(*WatchItemsRequest)
+		}
+		_param2 = make([][]grpc.CallOption, len(c.methodInvocations))
+		for u := 0; u < len(c.methodInvocations); u++ {
+			_param2[u] = make([]grpc.CallOption, len(params)-2)
+			for x := 2; x < len(params); x++ {
+				if params[x][u] != nil {
+					_param2[u][x-2] = params[x][u].(grpc.CallOption)
+				}
+			}
+		}
+	}
+	return
+}
+
+func (verifier *VerifierMockInventoryClient) ImportItems(ctx context.Context, opts ...grpc.CallOption) *MockInventoryClient_ImportItems_OngoingVerification {
+	params := []pegomock.Param{ctx}
+	for _, param := range opts {
+		params = append(params, param)
+	}
+	pegomockmock.ResetMatchers()
+	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "ImportItems", params, verifier.timeout)
+	return &MockInventoryClient_ImportItems_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+}
+
+type MockInventoryClient_ImportItems_OngoingVerification struct {
+	mock              *MockInventoryClient
+	methodInvocations []pegomock.MethodInvocation
+}
+
+func (c *MockInventoryClient_ImportItems_OngoingVerification) GetCapturedArguments() (context.Context, []grpc.CallOption) {
+	ctx, opts := c.GetAllCapturedArguments()
+	return ctx[len(ctx)-1], opts[len(opts)-1]
+}
+
+func (c *MockInventoryClient_ImportItems_OngoingVerification) GetAllCapturedArguments() (_param0 []context.Context, _param1 [][]grpc.CallOption) {
+	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
+	if len(params) > 0 {
+		_param0 = make([]context.Context, len(c.methodInvocations))
+		for u, param := range params[0] {
+			_param0[u] = param.(context.Context)
+		}
+		_param1 = make([][]grpc.CallOption, len(c.methodInvocations))
+		for u := 0; u < len(c.methodInvocations); u++ {
+			_param1[u] = make([]grpc.CallOption, len(params)-1)
+			for x := 1; x < len(params); x++ {
+				if params[x][u] != nil {
+					_param1[u][x-1] = params[x][u].(grpc.CallOption)
+				}
+			}
+		}
+	}
+	return
+}
+
+type MockInventoryServer struct {
+	UnimplementedInventoryServer
+	fail        func(message string, callerSkip ...int)
+	invocations pegomockmock.Invocations
+}
+
+func NewMockInventoryServer(options ...pegomock.Option) *MockInventoryServer {
+	mock := &MockInventoryServer{}
+	pegomockmock.TrackCoverage("editions.Inventory", []string{"GetItem", "WatchItems", "ImportItems"}, mock, &mock.invocations)
+	for _, option := range options {
+		option.Apply(mock)
+	}
+	return mock
+}
+
+func (mock *MockInventoryServer) SetFailHandler(fh pegomock.FailHandler) { mock.fail = fh }
+func (mock *MockInventoryServer) FailHandler() pegomock.FailHandler      { return mock.fail }
+
+func (mock *MockInventoryServer) GetItem(ctx context.Context, in *GetItemRequest) (*Item, error) {
+	if mock == nil {
+		panic("mock must not be nil. Use myMock := NewMockInventoryServer().")
+	}
+	params := []pegomock.Param{ctx, in}
+	mock.invocations.Invoked("GetItem", params)
+	result := pegomock.GetGenericMockFrom(mock).Invoke("GetItem", params, []reflect.Type{reflect.TypeOf((**Item)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
+	var ret0 *Item
+	var ret1 error
+	if len(result) != 0 {
+		if result[0] != nil {
+			ret0 = result[0].(*Item)
+		}
+		if result[1] != nil {
+			ret1 = result[1].(error)
+		}
+	}
+	return ret0, ret1
+}
+
+func (mock *MockInventoryServer) WatchItems(in *WatchItemsRequest, out Inventory_WatchItemsServer) error {
+	if mock == nil {
+		panic("mock must not be nil. Use myMock := NewMockInventoryServer().")
+	}
+	params := []pegomock.Param{in, out}
+	mock.invocations.Invoked("WatchItems", params)
+	result := pegomock.GetGenericMockFrom(mock).Invoke("WatchItems", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
+	var ret0 error
+	if len(result) != 0 {
+		if result[0] != nil {
+			ret0 = result[0].(error)
+		}
+	}
+	return ret0
+}
+
+func (mock *MockInventoryServer) ImportItems(out Inventory_ImportItemsServer) error {
+	if mock == nil {
+		panic("mock must not be nil. Use myMock := NewMockInventoryServer().")
+	}
+	params := []pegomock.Param{out}
+	mock.invocations.Invoked("ImportItems", params)
+	result := pegomock.GetGenericMockFrom(mock).Invoke("ImportItems", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
+	var ret0 error
+	if len(result) != 0 {
+		if result[0] != nil {
+			ret0 = result[0].(error)
+		}
+	}
+	return ret0
+}
+
+func (mock *MockInventoryServer) VerifyWasCalledOnce() *VerifierMockInventoryServer {
+	return &VerifierMockInventoryServer{
+		mock:                   mock,
+		invocationCountMatcher: pegomock.Times(1),
+	}
+}
+
+func (mock *MockInventoryServer) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *VerifierMockInventoryServer {
+	return &VerifierMockInventoryServer{
+		mock:                   mock,
+		invocationCountMatcher: invocationCountMatcher,
+	}
+}
+
+func (mock *MockInventoryServer) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *VerifierMockInventoryServer {
+	return &VerifierMockInventoryServer{
+		mock:                   mock,
+		invocationCountMatcher: invocationCountMatcher,
+		inOrderContext:         inOrderContext,
+	}
+}
+
+func (mock *MockInventoryServer) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *VerifierMockInventoryServer {
+	return &VerifierMockInventoryServer{
+		mock:                   mock,
+		invocationCountMatcher: invocationCountMatcher,
+		timeout:                timeout,
+	}
+}
+
+type VerifierMockInventoryServer struct {
+	mock                   *MockInventoryServer
+	invocationCountMatcher pegomock.InvocationCountMatcher
+	inOrderContext         *pegomock.InOrderContext
+	timeout                time.Duration
+}
+
+func (verifier *VerifierMockInventoryServer) GetItem(ctx context.Context, in *GetItemRequest) *MockInventoryServer_GetItem_OngoingVerification {
+	params := []pegomock.Param{ctx, in}
+	pegomockmock.ResetMatchers()
+	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "GetItem", params, verifier.timeout)
+	return &MockInventoryServer_GetItem_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+}
+
+type MockInventoryServer_GetItem_OngoingVerification struct {
+	mock              *MockInventoryServer
+	methodInvocations []pegomock.MethodInvocation
+}
+
+func (c *MockInventoryServer_GetItem_OngoingVerification) GetCapturedArguments() (context.Context, *GetItemRequest) {
+	ctx, in := c.GetAllCapturedArguments()
+	return ctx[len(ctx)-1], in[len(in)-1]
+}
+
+func (c *MockInventoryServer_GetItem_OngoingVerification) GetAllCapturedArguments() (_param0 []context.Context, _param1 []*GetItemRequest) {
+	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
+	if len(params) > 0 {
+		_param0 = make([]context.Context, len(c.methodInvocations))
+		for u, param := range params[0] {
+			_param0[u] = param.(context.Context)
+		}
+		_param1 = make([]*GetItemRequest, len(c.methodInvocations))
+		for u, param := range params[1] {
+			_param1[u] = param.(*GetItemRequest)
+		}
+	}
+	return
+}
+
+func (verifier *VerifierMockInventoryServer) WatchItems(in *WatchItemsRequest, out Inventory_WatchItemsServer) *MockInventoryServer_WatchItems_OngoingVerification {
+	params := []pegomock.Param{in, out}
+	pegomockmock.ResetMatchers()
+	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "WatchItems", params, verifier.timeout)
+	return &MockInventoryServer_WatchItems_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+}
+
+type MockInventoryServer_WatchItems_OngoingVerification struct {
+	mock              *MockInventoryServer
+	methodInvocations []pegomock.MethodInvocation
+}
+
+func (c *MockInventoryServer_WatchItems_OngoingVerification) GetCapturedArguments() (*WatchItemsRequest, Inventory_WatchItemsServer) {
+	in, out := c.GetAllCapturedArguments()
+	return in[len(in)-1], out[len(out)-1]
+}
+
+func (c *MockInventoryServer_WatchItems_OngoingVerification) GetAllCapturedArguments() (_param0 []*WatchItemsRequest, _param1 []Inventory_WatchItemsServer) {
+	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
+	if len(params) > 0 {
+		_param0 = make([]*WatchItemsRequest, len(c.methodInvocations))
+		for u, param := range params[0] {
+			_param0[u] = param.(*WatchItemsRequest)
+		}
+		_param1 = make([]Inventory_WatchItemsServer, len(c.methodInvocations))
+		for u, param := range params[1] {
+			_param1[u] = param.(Inventory_WatchItemsServer)
+		}
+	}
+	return
+}
+
+func (verifier *VerifierMockInventoryServer) ImportItems(out Inventory_ImportItemsServer) *MockInventoryServer_ImportItems_OngoingVerification {
+	params := []pegomock.Param{out}
+	pegomockmock.ResetMatchers()
+	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "ImportItems", params, verifier.timeout)
+	return &MockInventoryServer_ImportItems_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+}
+
+type MockInventoryServer_ImportItems_OngoingVerification struct {
+	mock              *MockInventoryServer
+	methodInvocations []pegomock.MethodInvocation
+}
+
+func (c *MockInventoryServer_ImportItems_OngoingVerification) GetCapturedArguments() Inventory_ImportItemsServer {
+	out := c.GetAllCapturedArguments()
+	return out[len(out)-1]
+}
+
+func (c *MockInventoryServer_ImportItems_OngoingVerification) GetAllCapturedArguments() (_param0 []Inventory_ImportItemsServer) {
+	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
+	if len(params) > 0 {
+		_param0 = make([]Inventory_ImportItemsServer, len(c.methodInvocations))
+		for u, param := range params[0] {
+			_param0[u] = param.(Inventory_ImportItemsServer)
+		}
+	}
+	return
+}
+
+type MockInventory_WatchItemsClient struct {
+	pegomockmock.ServerStreamingClient[ItemEvent]
+}
+
+func NewMockInventory_WatchItemsClient(options ...pegomock.Option) *MockInventory_WatchItemsClient {
+	mock := &MockInventory_WatchItemsClient{}
+	for _, option := range options {
+		option.Apply(mock)
+	}
+	return mock
+}
+
+type MockInventory_WatchItemsServer struct {
+	pegomockmock.ServerStreamingServer[ItemEvent]
+}
+
+func NewMockInventory_WatchItemsServer(options ...pegomock.Option) *MockInventory_WatchItemsServer {
+	mock := &MockInventory_WatchItemsServer{}
+	for _, option := range options {
+		option.Apply(mock)
+	}
+	return mock
+}
+
+type MockInventory_ImportItemsClient struct {
+	pegomockmock.ClientStreamingClient[Item, ImportItemsResponse]
+}
+
+func NewMockInventory_ImportItemsClient(options ...pegomock.Option) *MockInventory_ImportItemsClient {
+	mock := &MockInventory_ImportItemsClient{}
+	for _, option := range options {
+		option.Apply(mock)
+	}
+	return mock
+}
+
+type MockInventory_ImportItemsServer struct {
+	pegomockmock.ClientStreamingServer[Item, ImportItemsResponse]
+}
+
+func NewMockInventory_ImportItemsServer(options ...pegomock.Option) *MockInventory_ImportItemsServer {
+	mock := &MockInventory_ImportItemsServer{}
+	for _, option := range options {
+		option.Apply(mock)
+	}
+	return mock
+}
+
+func NewInventory_WatchItemsPipe(ctx context.Context) (*grpcmock.ClientStream[WatchItemsRequest, ItemEvent], *grpcmock.ServerStream[WatchItemsRequest, ItemEvent]) {
+	return grpcmock.NewPipe[WatchItemsRequest, ItemEvent](ctx, "/editions.Inventory/WatchItems")
+}
+
+func NewInventory_ImportItemsPipe(ctx context.Context) (*grpcmock.ClientStream[Item, ImportItemsResponse], *grpcmock.ServerStream[Item, ImportItemsResponse]) {
+	return grpcmock.NewPipe[Item, ImportItemsResponse](ctx, "/editions.Inventory/ImportItems")
+}
+
+type interceptedInventoryClient struct {
+	client       InventoryClient
+	interceptors grpcmock.ClientInterceptors
+}
+
+func NewInventoryClientWithInterceptors(client InventoryClient, interceptors grpcmock.ClientInterceptors) InventoryClient {
+	return &interceptedInventoryClient{client: client, interceptors: interceptors}
+}
+
+func (c *interceptedInventoryClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*Item, error) {
+	return grpcmock.InterceptUnaryClient(ctx, c.interceptors.Unary, "/editions.Inventory/GetItem", in, opts, c.client.GetItem)
+}
+
+func (c *interceptedInventoryClient) WatchItems(ctx context.Context, in *WatchItemsRequest, opts ...grpc.CallOption) (Inventory_WatchItemsClient, error) {
+	return grpcmock.InterceptStreamClient(ctx, c.interceptors.Stream, &grpc.StreamDesc{StreamName: "WatchItems", ClientStreams: false, ServerStreams: true}, "/editions.Inventory/WatchItems", opts,
+		func(ctx context.Context, opts ...grpc.CallOption) (Inventory_WatchItemsClient, error) {
+			return c.client.WatchItems(ctx, in, opts...)
+		},
+		func(s grpc.ClientStream) Inventory_WatchItemsClient {
+			return &grpcmock.InterceptedClientStream[WatchItemsRequest, ItemEvent]{ClientStream: s}
+		})
+}
+
+func (c *interceptedInventoryClient) ImportItems(ctx context.Context, opts ...grpc.CallOption) (Inventory_ImportItemsClient, error) {
+	return grpcmock.InterceptStreamClient(ctx, c.interceptors.Stream, &grpc.StreamDesc{StreamName: "ImportItems", ClientStreams: true, ServerStreams: false}, "/editions.Inventory/ImportItems", opts,
+		c.client.ImportItems,
+		func(s grpc.ClientStream) Inventory_ImportItemsClient {
+			return &grpcmock.InterceptedClientStream[Item, ImportItemsResponse]{ClientStream: s}
+		})
+}
+
+type interceptedInventoryServer struct {
+	UnimplementedInventoryServer
+	server       InventoryServer
+	interceptors grpcmock.ServerInterceptors
+}
+
+func NewInventoryServerWithInterceptors(server InventoryServer, interceptors grpcmock.ServerInterceptors) InventoryServer {
+	return &interceptedInventoryServer{server: server, interceptors: interceptors}
+}
+
+func (s *interceptedInventoryServer) GetItem(ctx context.Context, in *GetItemRequest) (*Item, error) {
+	return grpcmock.InterceptUnaryServer(ctx, s.interceptors.Unary, s.server, "/editions.Inventory/GetItem", in, s.server.GetItem)
+}
+
+func (s *interceptedInventoryServer) WatchItems(in *WatchItemsRequest, stream Inventory_WatchItemsServer) error {
+	return grpcmock.InterceptStreamServer(s.interceptors.Stream, s.server, &grpc.StreamDesc{StreamName: "WatchItems", ClientStreams: false, ServerStreams: true}, "/editions.Inventory/WatchItems", stream,
+		func(stream Inventory_WatchItemsServer) error {
+			return s.server.WatchItems(in, stream)
+		},
+		func(ss grpc.ServerStream) Inventory_WatchItemsServer {
+			return &grpcmock.InterceptedServerStream[WatchItemsRequest, ItemEvent]{ServerStream: ss}
+		})
+}
+
+func (s *interceptedInventoryServer) ImportItems(stream Inventory_ImportItemsServer) error {
+	return grpcmock.InterceptStreamServer(s.interceptors.Stream, s.server, &grpc.StreamDesc{StreamName: "ImportItems", ClientStreams: true, ServerStreams: false}, "/editions.Inventory/ImportItems", stream,
+		s.server.ImportItems,
+		func(ss grpc.ServerStream) Inventory_ImportItemsServer {
+			return &grpcmock.InterceptedServerStream[Item, ImportItemsResponse]{ServerStream: ss}
+		})
+}
+
+var (
+	_ InventoryClient             = (*MockInventoryClient)(nil)
+	_ InventoryServer             = (*MockInventoryServer)(nil)
+	_ Inventory_WatchItemsClient  = (*MockInventory_WatchItemsClient)(nil)
+	_ Inventory_WatchItemsServer  = (*MockInventory_WatchItemsServer)(nil)
+	_ Inventory_WatchItemsClient  = (*grpcmock.ClientStream[WatchItemsRequest, ItemEvent])(nil)
+	_ Inventory_WatchItemsServer  = (*grpcmock.ServerStream[WatchItemsRequest, ItemEvent])(nil)
+	_ Inventory_WatchItemsClient  = (*grpcmock.InterceptedClientStream[WatchItemsRequest, ItemEvent])(nil)
+	_ Inventory_WatchItemsServer  = (*grpcmock.InterceptedServerStream[WatchItemsRequest, ItemEvent])(nil)
+	_ Inventory_ImportItemsClient = (*MockInventory_ImportItemsClient)(nil)
+	_ Inventory_ImportItemsServer = (*MockInventory_ImportItemsServer)(nil)
+	_ Inventory_ImportItemsClient = (*grpcmock.ClientStream[Item, ImportItemsResponse])(nil)
+	_ Inventory_ImportItemsServer = (*grpcmock.ServerStream[Item, ImportItemsResponse])(nil)
+	_ Inventory_ImportItemsClient = (*grpcmock.InterceptedClientStream[Item, ImportItemsResponse])(nil)
+	_ Inventory_ImportItemsServer = (*grpcmock.InterceptedServerStream[Item, ImportItemsResponse])(nil)
+)
+
+func AnyEditionsInventoryImportItemsClient() Inventory_ImportItemsClient {
+	return pegomockmock.AnyArg[Inventory_ImportItemsClient]()
+}
+
+func EqEditionsInventoryImportItemsClient(value Inventory_ImportItemsClient) Inventory_ImportItemsClient {
+	return pegomockmock.EqArg[Inventory_ImportItemsClient](value)
+}
+
+func NotEqEditionsInventoryImportItemsClient(value Inventory_ImportItemsClient) Inventory_ImportItemsClient {
+	return pegomockmock.NotEqArg[Inventory_ImportItemsClient](value)
+}
+
+func EditionsInventoryImportItemsClientThat(matcher pegomock.ArgumentMatcher) Inventory_ImportItemsClient {
+	return pegomockmock.MatchArg[Inventory_ImportItemsClient](matcher)
+}
+
+func AnyEditionsInventoryImportItemsServer() Inventory_ImportItemsServer {
+	return pegomockmock.AnyArg[Inventory_ImportItemsServer]()
+}
+
+func EqEditionsInventoryImportItemsServer(value Inventory_ImportItemsServer) Inventory_ImportItemsServer {
+	return pegomockmock.EqArg[Inventory_ImportItemsServer](value)
+}
+
+func NotEqEditionsInventoryImportItemsServer(value Inventory_ImportItemsServer) Inventory_ImportItemsServer {
+	return pegomockmock.NotEqArg[Inventory_ImportItemsServer](value)
+}
+
+func EditionsInventoryImportItemsServerThat(matcher pegomock.ArgumentMatcher) Inventory_ImportItemsServer {
+	return pegomockmock.MatchArg[Inventory_ImportItemsServer](matcher)
+}
+
+func AnyEditionsInventoryWatchItemsClient() Inventory_WatchItemsClient {
+	return pegomockmock.AnyArg[Inventory_WatchItemsClient]()
+}
+
+func EqEditionsInventoryWatchItemsClient(value Inventory_WatchItemsClient) Inventory_WatchItemsClient {
+	return pegomockmock.EqArg[Inventory_WatchItemsClient](value)
+}
+
+func NotEqEditionsInventoryWatchItemsClient(value Inventory_WatchItemsClient) Inventory_WatchItemsClient {
+	return pegomockmock.NotEqArg[Inventory_WatchItemsClient](value)
+}
+
+func EditionsInventoryWatchItemsClientThat(matcher pegomock.ArgumentMatcher) Inventory_WatchItemsClient {
+	return pegomockmock.MatchArg[Inventory_WatchItemsClient](matcher)
+}
+
+func AnyEditionsInventoryWatchItemsServer() Inventory_WatchItemsServer {
+	return pegomockmock.AnyArg[Inventory_WatchItemsServer]()
+}
+
+func EqEditionsInventoryWatchItemsServer(value Inventory_WatchItemsServer) Inventory_WatchItemsServer {
+	return pegomockmock.EqArg[Inventory_WatchItemsServer](value)
+}
+
+func NotEqEditionsInventoryWatchItemsServer(value Inventory_WatchItemsServer) Inventory_WatchItemsServer {
+	return pegomockmock.NotEqArg[Inventory_WatchItemsServer](value)
+}
+
+func EditionsInventoryWatchItemsServerThat(matcher pegomock.ArgumentMatcher) Inventory_WatchItemsServer {
+	return pegomockmock.MatchArg[Inventory_WatchItemsServer](matcher)
+}
+
+func AnyMetadataMD() metadata.MD {
+	return pegomockmock.AnyArg[metadata.MD]()
+}
+
+func EqMetadataMD(value metadata.MD) metadata.MD {
+	return pegomockmock.EqArg[metadata.MD](value)
+}
+
+func NotEqMetadataMD(value metadata.MD) metadata.MD {
+	return pegomockmock.NotEqArg[metadata.MD](value)
+}
+
+func MetadataMDThat(matcher pegomock.ArgumentMatcher) metadata.MD {
+	return pegomockmock.MatchArg[metadata.MD](matcher)
+}
+
+func AnyPtrToEditionsGetItemRequest() *GetItemRequest {
+	return pegomockmock.AnyArg[*GetItemRequest]()
+}
+
+func EqPtrToEditionsGetItemRequest(value *GetItemRequest) *GetItemRequest {
+	return pegomockmock.EqArg[*GetItemRequest](value)
+}
+
+func NotEqPtrToEditionsGetItemRequest(value *GetItemRequest) *GetItemRequest {
+	return pegomockmock.NotEqArg[*GetItemRequest](value)
+}
+
+func PtrToEditionsGetItemRequestThat(matcher pegomock.ArgumentMatcher) *GetItemRequest {
+	return pegomockmock.MatchArg[*GetItemRequest](matcher)
+}
+
+func AnyPtrToEditionsImportItemsResponse() *ImportItemsResponse {
+	return pegomockmock.AnyArg[*ImportItemsResponse]()
+}
+
+func EqPtrToEditionsImportItemsResponse(value *ImportItemsResponse) *ImportItemsResponse {
+	return pegomockmock.EqArg[*ImportItemsResponse](value)
+}
+
+func NotEqPtrToEditionsImportItemsResponse(value *ImportItemsResponse) *ImportItemsResponse {
+	return pegomockmock.NotEqArg[*ImportItemsResponse](value)
+}
+
+func PtrToEditionsImportItemsResponseThat(matcher pegomock.ArgumentMatcher) *ImportItemsResponse {
+	return pegomockmock.MatchArg[*ImportItemsResponse](matcher)
+}
+
+func AnyPtrToEditionsItem() *Item {
+	return pegomockmock.AnyArg[*Item]()
+}
+
+func EqPtrToEditionsItem(value *Item) *Item {
+	return pegomockmock.EqArg[*Item](value)
+}
+
+func NotEqPtrToEditionsItem(value *Item) *Item {
+	return pegomockmock.NotEqArg[*Item](value)
+}
+
+func PtrToEditionsItemThat(matcher pegomock.ArgumentMatcher) *Item {
+	return pegomockmock.MatchArg[*Item](matcher)
+}
+
+func AnyPtrToEditionsItemEvent() *ItemEvent {
+	return pegomockmock.AnyArg[*ItemEvent]()
+}
+
+func EqPtrToEditionsItemEvent(value *ItemEvent) *ItemEvent {
+	return pegomockmock.EqArg[*ItemEvent](value)
+}
+
+func NotEqPtrToEditionsItemEvent(value *ItemEvent) *ItemEvent {
+	return pegomockmock.NotEqArg[*ItemEvent](value)
+}
+
+func PtrToEditionsItemEventThat(matcher pegomock.ArgumentMatcher) *ItemEvent {
+	return pegomockmock.MatchArg[*ItemEvent](matcher)
+}
+
+func AnyPtrToEditionsWatchItemsRequest() *WatchItemsRequest {
+	return pegomockmock.AnyArg[*WatchItemsRequest]()
+}
+
+func EqPtrToEditionsWatchItemsRequest(value *WatchItemsRequest) *WatchItemsRequest {
+	return pegomockmock.EqArg[*WatchItemsRequest](value)
+}
+
+func NotEqPtrToEditionsWatchItemsRequest(value *WatchItemsRequest) *WatchItemsRequest {
+	return pegomockmock.NotEqArg[*WatchItemsRequest](value)
+}
+
+func PtrToEditionsWatchItemsRequestThat(matcher pegomock.ArgumentMatcher) *WatchItemsRequest {
+	return pegomockmock.MatchArg[*WatchItemsRequest](matcher)
+}
+
+func CaptureGetItemRequest() *pegomockmock.Captor[GetItemRequest] {
+	return pegomockmock.NewCaptor[GetItemRequest]()
+}
+
+func CaptureItem() *pegomockmock.Captor[Item] {
+	return pegomockmock.NewCaptor[Item]()
+}
+
+func CaptureWatchItemsRequest() *pegomockmock.Captor[WatchItemsRequest] {
+	return pegomockmock.NewCaptor[WatchItemsRequest]()
+}
+
+func CaptureItemEvent() *pegomockmock.Captor[ItemEvent] {
+	return pegomockmock.NewCaptor[ItemEvent]()
+}
+
+func CaptureImportItemsResponse() *pegomockmock.Captor[ImportItemsResponse] {
+	return pegomockmock.NewCaptor[ImportItemsResponse]()
+}
+
+// GetItemRequestField is the name of a field of GetItemRequest.
+type GetItemRequestField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f GetItemRequestField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	GetItemRequestField_Name = GetItemRequestField{name: "name"}
+)
+
+func EqGetItemRequestIgnoring(v *GetItemRequest, fields ...GetItemRequestField) *GetItemRequest {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqGetItemRequestMasked(v *GetItemRequest, mask *fieldmaskpb.FieldMask) *GetItemRequest {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+// ItemField is the name of a field of Item.
+type ItemField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f ItemField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	ItemField_Name       = ItemField{name: "name"}
+	ItemField_Quantity   = ItemField{name: "quantity"}
+	ItemField_Dimensions = ItemField{name: "dimensions"}
+)
+
+func EqItemIgnoring(v *Item, fields ...ItemField) *Item {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqItemMasked(v *Item, mask *fieldmaskpb.FieldMask) *Item {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+// WatchItemsRequestField is the name of a field of WatchItemsRequest.
+type WatchItemsRequestField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f WatchItemsRequestField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	WatchItemsRequestField_Prefix = WatchItemsRequestField{name: "prefix"}
+)
+
+func EqWatchItemsRequestIgnoring(v *WatchItemsRequest, fields ...WatchItemsRequestField) *WatchItemsRequest {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqWatchItemsRequestMasked(v *WatchItemsRequest, mask *fieldmaskpb.FieldMask) *WatchItemsRequest {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+// ItemEventField is the name of a field of ItemEvent.
+type ItemEventField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f ItemEventField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	ItemEventField_Item    = ItemEventField{name: "item"}
+	ItemEventField_Deleted = ItemEventField{name: "deleted"}
+)
+
+func EqItemEventIgnoring(v *ItemEvent, fields ...ItemEventField) *ItemEvent {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqItemEventMasked(v *ItemEvent, mask *fieldmaskpb.FieldMask) *ItemEvent {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+// ImportItemsResponseField is the name of a field of ImportItemsResponse.
+type ImportItemsResponseField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f ImportItemsResponseField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	ImportItemsResponseField_Count = ImportItemsResponseField{name: "count"}
+)
+
+func EqImportItemsResponseIgnoring(v *ImportItemsResponse, fields ...ImportItemsResponseField) *ImportItemsResponse {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqImportItemsResponseMasked(v *ImportItemsResponse, mask *fieldmaskpb.FieldMask) *ImportItemsResponse {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+func EqualGetItemRequest(v *GetItemRequest) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func EqualItem(v *Item) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func EqualWatchItemsRequest(v *WatchItemsRequest) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func EqualItemEvent(v *ItemEvent) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func EqualImportItemsResponse(v *ImportItemsResponse) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func HaveReceivedGetItem(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(pegomockmock.Received, "GetItem", args...)
+}
+
+func HaveReceivedWatchItems(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(pegomockmock.Received, "WatchItems", args...)
+}
+
+func HaveReceivedImportItems(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(pegomockmock.Received, "ImportItems", args...)
+}
@@ -0,0 +1,70 @@
+package editions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/petergtz/pegomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+var Table = &Item{
+	Name:       "table",
+	Quantity:   proto.Int32(0),
+	Dimensions: &Item_Dimensions{Width: 120, Height: 75},
+}
+
+func TestGetItem(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// Create a new mock client for the Inventory service.
+	m := NewMockInventoryClient(pegomock.WithT(t))
+
+	// Set up the expectation.
+	ctx := context.Background()
+	req := &GetItemRequest{Name: "table"}
+	pegomock.When(m.GetItem(ctx, req)).ThenReturn(Table, nil)
+
+	// Call the client.
+	r, err := m.GetItem(ctx, req)
+
+	// Check that the response, including the explicit zero quantity, is as expected.
+	require.NoError(t, err)
+	g.Expect(r).To(EqualItem(&Item{
+		Name:       "table",
+		Quantity:   proto.Int32(0),
+		Dimensions: &Item_Dimensions{Width: 120, Height: 75},
+	}))
+	g.Expect(r.Quantity).NotTo(gomega.BeNil())
+	g.Expect(m).To(HaveReceivedGetItem(ctx, &GetItemRequest{Name: "table"}))
+}
+
+func TestWatchItems(t *testing.T) {
+	// Create a new mock client for the Inventory service.
+	m := NewMockInventoryClient(pegomock.WithT(t))
+
+	// Create the stream, which returns an event with a delimited item.
+	ctx := context.Background()
+	req := &WatchItemsRequest{Prefix: "t"}
+	res := NewMockInventory_WatchItemsClient(pegomock.WithT(t))
+	pegomock.When(res.Recv()).ThenReturn(&ItemEvent{Item: Table}, nil)
+
+	// Set up the expectation.
+	pegomock.When(m.WatchItems(ctx, req)).ThenReturn(res, nil)
+
+	// Call the client and use the streaming handler.
+	r, err := m.WatchItems(ctx, req)
+	require.NoError(t, err)
+	e, err := r.Recv()
+	require.NoError(t, err)
+
+	// Check that the delimited item survives a round trip in the wire format.
+	b, err := proto.Marshal(e)
+	require.NoError(t, err)
+	got := &ItemEvent{}
+	require.NoError(t, proto.Unmarshal(b, got))
+	assert.True(t, proto.Equal(Table, got.GetItem()))
+}
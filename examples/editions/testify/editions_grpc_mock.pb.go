@@ -0,0 +1,460 @@
+// Code generated by protoc-gen-go-grpcmock. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpcmock v1.3.0
+// - protoc                 v5.27.0
+// - testify                v1.8.4
+// source: editions.proto
+
+package editions
+
+import (
+	context "context"
+	grpcmock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+	gomegamock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/gomegamock"
+	testifymock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/testifymock"
+	types "github.com/onsi/gomega/types"
+	mock "github.com/stretchr/testify/mock"
+	grpc "google.golang.org/grpc"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func AnyGetItemRequest() mock.AnythingOfTypeArgument {
+	return mock.AnythingOfType("*editions.GetItemRequest")
+}
+
+func EqGetItemRequest(v *GetItemRequest) interface{} {
+	return testifymock.EqMessage(v)
+}
+
+func AnyItem() mock.AnythingOfTypeArgument {
+	return mock.AnythingOfType("*editions.Item")
+}
+
+func EqItem(v *Item) interface{} {
+	return testifymock.EqMessage(v)
+}
+
+func AnyWatchItemsRequest() mock.AnythingOfTypeArgument {
+	return mock.AnythingOfType("*editions.WatchItemsRequest")
+}
+
+func EqWatchItemsRequest(v *WatchItemsRequest) interface{} {
+	return testifymock.EqMessage(v)
+}
+
+func AnyItemEvent() mock.AnythingOfTypeArgument {
+	return mock.AnythingOfType("*editions.ItemEvent")
+}
+
+func EqItemEvent(v *ItemEvent) interface{} {
+	return testifymock.EqMessage(v)
+}
+
+func AnyImportItemsResponse() mock.AnythingOfTypeArgument {
+	return mock.AnythingOfType("*editions.ImportItemsResponse")
+}
+
+func EqImportItemsResponse(v *ImportItemsResponse) interface{} {
+	return testifymock.EqMessage(v)
+}
+
+func AnyInventory_WatchItemsClient() mock.AnythingOfTypeArgument {
+	return mock.AnythingOfType("*editions.Inventory_WatchItemsClient")
+}
+
+func AnyInventory_WatchItemsServer() mock.AnythingOfTypeArgument {
+	return mock.AnythingOfType("*editions.Inventory_WatchItemsServer")
+}
+
+func AnyInventory_ImportItemsClient() mock.AnythingOfTypeArgument {
+	return mock.AnythingOfType("*editions.Inventory_ImportItemsClient")
+}
+
+func AnyInventory_ImportItemsServer() mock.AnythingOfTypeArgument {
+	return mock.AnythingOfType("*editions.Inventory_ImportItemsServer")
+}
+
+type MockInventoryClient struct {
+	mock.Mock
+	expectations testifymock.Expectations
+}
+
+func (m *MockInventoryClient) On(method string, args ...interface{}) *mock.Call {
+	return testifymock.On(&m.Mock, &m.expectations, method, args...)
+}
+
+func NewMockInventoryClient() *MockInventoryClient {
+	m := &MockInventoryClient{}
+	testifymock.TrackCoverage("editions.Inventory", []string{"GetItem", "WatchItems", "ImportItems"}, &m.expectations)
+	return m
+}
+
+func (c *MockInventoryClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*Item, error) {
+	opts0 := []interface{}{ctx, in}
+	for _, opts1 := range opts {
+		opts0 = append(opts0, opts1)
+	}
+	args := testifymock.Called(&c.Mock, &c.expectations, "GetItem", opts0...)
+	return args.Get(0).(*Item), args.Error(1)
+}
+
+func (c *MockInventoryClient) OnGetItem(ctx interface{}, in interface{}, opts ...interface{}) *mock.Call {
+	return testifymock.On(&c.Mock, &c.expectations, "GetItem", append([]interface{}{ctx, in}, opts...)...)
+}
+
+func (c *MockInventoryClient) WatchItems(ctx context.Context, in *WatchItemsRequest, opts ...grpc.CallOption) (Inventory_WatchItemsClient, error) {
+	opts0 := []interface{}{ctx, in}
+	for _, opts1 := range opts {
+		opts0 = append(opts0, opts1)
+	}
+	args := testifymock.Called(&c.Mock, &c.expectations, "WatchItems", opts0...)
+	return args.Get(0).(Inventory_WatchItemsClient), args.Error(1)
+}
+
+func (c *MockInventoryClient) OnWatchItems(ctx interface{}, in interface{}, opts ...interface{}) *mock.Call {
+	return testifymock.On(&c.Mock, &c.expectations, "WatchItems", append([]interface{}{ctx, in}, opts...)...)
+}
+
+type MockInventory_WatchItemsClient struct {
+	testifymock.ServerStreamingClient[ItemEvent]
+}
+
+func NewMockInventory_WatchItemsClient() *MockInventory_WatchItemsClient {
+	return &MockInventory_WatchItemsClient{}
+}
+
+// Deprecated: Do not use.
+func (c *MockInventoryClient) ImportItems(ctx context.Context, opts ...grpc.CallOption) (Inventory_ImportItemsClient, error) {
+	opts0 := []interface{}{ctx}
+	for _, opts1 := range opts {
+		opts0 = append(opts0, opts1)
+	}
+	args := testifymock.Called(&c.Mock, &c.expectations, "ImportItems", opts0...)
+	return args.Get(0).(Inventory_ImportItemsClient), args.Error(1)
+}
+
+func (c *MockInventoryClient) OnImportItems(ctx interface{}, opts ...interface{}) *mock.Call {
+	return testifymock.On(&c.Mock, &c.expectations, "ImportItems", append([]interface{}{ctx}, opts...)...)
+}
+
+type MockInventory_ImportItemsClient struct {
+	testifymock.ClientStreamingClient[Item, ImportItemsResponse]
+}
+
+func NewMockInventory_ImportItemsClient() *MockInventory_ImportItemsClient {
+	return &MockInventory_ImportItemsClient{}
+}
+
+type MockInventoryServer struct {
+	mock.Mock
+	UnimplementedInventoryServer
+	expectations testifymock.Expectations
+}
+
+func (m *MockInventoryServer) On(method string, args ...interface{}) *mock.Call {
+	return testifymock.On(&m.Mock, &m.expectations, method, args...)
+}
+
+func NewMockInventoryServer() *MockInventoryServer {
+	m := &MockInventoryServer{}
+	testifymock.TrackCoverage("editions.Inventory", []string{"GetItem", "WatchItems", "ImportItems"}, &m.expectations)
+	return m
+}
+
+func (s *MockInventoryServer) GetItem(ctx context.Context, in *GetItemRequest) (*Item, error) {
+	args := testifymock.Called(&s.Mock, &s.expectations, "GetItem", ctx, in)
+	return args.Get(0).(*Item), args.Error(1)
+}
+
+func (s *MockInventoryServer) OnGetItem(ctx interface{}, in interface{}) *mock.Call {
+	return testifymock.On(&s.Mock, &s.expectations, "GetItem", ctx, in)
+}
+
+func (s *MockInventoryServer) WatchItems(in *WatchItemsRequest, out Inventory_WatchItemsServer) error {
+	args := testifymock.Called(&s.Mock, &s.expectations, "WatchItems", in, out)
+	return args.Error(0)
+}
+
+func (s *MockInventoryServer) OnWatchItems(in interface{}, out interface{}) *mock.Call {
+	return testifymock.On(&s.Mock, &s.expectations, "WatchItems", in, out)
+}
+
+type MockInventory_WatchItemsServer struct {
+	testifymock.ServerStreamingServer[ItemEvent]
+}
+
+func NewMockInventory_WatchItemsServer() *MockInventory_WatchItemsServer {
+	return &MockInventory_WatchItemsServer{}
+}
+
+// Deprecated: Do not use.
+func (s *MockInventoryServer) ImportItems(out Inventory_ImportItemsServer) error {
+	args := testifymock.Called(&s.Mock, &s.expectations, "ImportItems", out)
+	return args.Error(0)
+}
+
+func (s *MockInventoryServer) OnImportItems(out interface{}) *mock.Call {
+	return testifymock.On(&s.Mock, &s.expectations, "ImportItems", out)
+}
+
+type MockInventory_ImportItemsServer struct {
+	testifymock.ClientStreamingServer[Item, ImportItemsResponse]
+}
+
+func NewMockInventory_ImportItemsServer() *MockInventory_ImportItemsServer {
+	return &MockInventory_ImportItemsServer{}
+}
+
+func NewInventory_WatchItemsPipe(ctx context.Context) (*grpcmock.ClientStream[WatchItemsRequest, ItemEvent], *grpcmock.ServerStream[WatchItemsRequest, ItemEvent]) {
+	return grpcmock.NewPipe[WatchItemsRequest, ItemEvent](ctx, "/editions.Inventory/WatchItems")
+}
+
+func NewInventory_ImportItemsPipe(ctx context.Context) (*grpcmock.ClientStream[Item, ImportItemsResponse], *grpcmock.ServerStream[Item, ImportItemsResponse]) {
+	return grpcmock.NewPipe[Item, ImportItemsResponse](ctx, "/editions.Inventory/ImportItems")
+}
+
+type interceptedInventoryClient struct {
+	client       InventoryClient
+	interceptors grpcmock.ClientInterceptors
+}
+
+func NewInventoryClientWithInterceptors(client InventoryClient, interceptors grpcmock.ClientInterceptors) InventoryClient {
+	return &interceptedInventoryClient{client: client, interceptors: interceptors}
+}
+
+func (c *interceptedInventoryClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*Item, error) {
+	return grpcmock.InterceptUnaryClient(ctx, c.interceptors.Unary, "/editions.Inventory/GetItem", in, opts, c.client.GetItem)
+}
+
+func (c *interceptedInventoryClient) WatchItems(ctx context.Context, in *WatchItemsRequest, opts ...grpc.CallOption) (Inventory_WatchItemsClient, error) {
+	return grpcmock.InterceptStreamClient(ctx, c.interceptors.Stream, &grpc.StreamDesc{StreamName: "WatchItems", ClientStreams: false, ServerStreams: true}, "/editions.Inventory/WatchItems", opts,
+		func(ctx context.Context, opts ...grpc.CallOption) (Inventory_WatchItemsClient, error) {
+			return c.client.WatchItems(ctx, in, opts...)
+		},
+		func(s grpc.ClientStream) Inventory_WatchItemsClient {
+			return &grpcmock.InterceptedClientStream[WatchItemsRequest, ItemEvent]{ClientStream: s}
+		})
+}
+
+func (c *interceptedInventoryClient) ImportItems(ctx context.Context, opts ...grpc.CallOption) (Inventory_ImportItemsClient, error) {
+	return grpcmock.InterceptStreamClient(ctx, c.interceptors.Stream, &grpc.StreamDesc{StreamName: "ImportItems", ClientStreams: true, ServerStreams: false}, "/editions.Inventory/ImportItems", opts,
+		c.client.ImportItems,
+		func(s grpc.ClientStream) Inventory_ImportItemsClient {
+			return &grpcmock.InterceptedClientStream[Item, ImportItemsResponse]{ClientStream: s}
+		})
+}
+
+type interceptedInventoryServer struct {
+	UnimplementedInventoryServer
+	server       InventoryServer
+	interceptors grpcmock.ServerInterceptors
+}
+
+func NewInventoryServerWithInterceptors(server InventoryServer, interceptors grpcmock.ServerInterceptors) InventoryServer {
+	return &interceptedInventoryServer{server: server, interceptors: interceptors}
+}
+
+func (s *interceptedInventoryServer) GetItem(ctx context.Context, in *GetItemRequest) (*Item, error) {
+	return grpcmock.InterceptUnaryServer(ctx, s.interceptors.Unary, s.server, "/editions.Inventory/GetItem", in, s.server.GetItem)
+}
+
+func (s *interceptedInventoryServer) WatchItems(in *WatchItemsRequest, stream Inventory_WatchItemsServer) error {
+	return grpcmock.InterceptStreamServer(s.interceptors.Stream, s.server, &grpc.StreamDesc{StreamName: "WatchItems", ClientStreams: false, ServerStreams: true}, "/editions.Inventory/WatchItems", stream,
+		func(stream Inventory_WatchItemsServer) error {
+			return s.server.WatchItems(in, stream)
+		},
+		func(ss grpc.ServerStream) Inventory_WatchItemsServer {
+			return &grpcmock.InterceptedServerStream[WatchItemsRequest, ItemEvent]{ServerStream: ss}
+		})
+}
+
+func (s *interceptedInventoryServer) ImportItems(stream Inventory_ImportItemsServer) error {
+	return grpcmock.InterceptStreamServer(s.interceptors.Stream, s.server, &grpc.StreamDesc{StreamName: "ImportItems", ClientStreams: true, ServerStreams: false}, "/editions.Inventory/ImportItems", stream,
+		s.server.ImportItems,
+		func(ss grpc.ServerStream) Inventory_ImportItemsServer {
+			return &grpcmock.InterceptedServerStream[Item, ImportItemsResponse]{ServerStream: ss}
+		})
+}
+
+var (
+	_ InventoryClient             = (*MockInventoryClient)(nil)
+	_ InventoryServer             = (*MockInventoryServer)(nil)
+	_ Inventory_WatchItemsClient  = (*MockInventory_WatchItemsClient)(nil)
+	_ Inventory_WatchItemsServer  = (*MockInventory_WatchItemsServer)(nil)
+	_ Inventory_WatchItemsClient  = (*grpcmock.ClientStream[WatchItemsRequest, ItemEvent])(nil)
+	_ Inventory_WatchItemsServer  = (*grpcmock.ServerStream[WatchItemsRequest, ItemEvent])(nil)
+	_ Inventory_WatchItemsClient  = (*grpcmock.InterceptedClientStream[WatchItemsRequest, ItemEvent])(nil)
+	_ Inventory_WatchItemsServer  = (*grpcmock.InterceptedServerStream[WatchItemsRequest, ItemEvent])(nil)
+	_ Inventory_ImportItemsClient = (*MockInventory_ImportItemsClient)(nil)
+	_ Inventory_ImportItemsServer = (*MockInventory_ImportItemsServer)(nil)
+	_ Inventory_ImportItemsClient = (*grpcmock.ClientStream[Item, ImportItemsResponse])(nil)
+	_ Inventory_ImportItemsServer = (*grpcmock.ServerStream[Item, ImportItemsResponse])(nil)
+	_ Inventory_ImportItemsClient = (*grpcmock.InterceptedClientStream[Item, ImportItemsResponse])(nil)
+	_ Inventory_ImportItemsServer = (*grpcmock.InterceptedServerStream[Item, ImportItemsResponse])(nil)
+)
+
+func CaptureGetItemRequest() *testifymock.Captor[GetItemRequest] {
+	return testifymock.NewCaptor[GetItemRequest]()
+}
+
+func CaptureItem() *testifymock.Captor[Item] {
+	return testifymock.NewCaptor[Item]()
+}
+
+func CaptureWatchItemsRequest() *testifymock.Captor[WatchItemsRequest] {
+	return testifymock.NewCaptor[WatchItemsRequest]()
+}
+
+func CaptureItemEvent() *testifymock.Captor[ItemEvent] {
+	return testifymock.NewCaptor[ItemEvent]()
+}
+
+func CaptureImportItemsResponse() *testifymock.Captor[ImportItemsResponse] {
+	return testifymock.NewCaptor[ImportItemsResponse]()
+}
+
+// GetItemRequestField is the name of a field of GetItemRequest.
+type GetItemRequestField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f GetItemRequestField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	GetItemRequestField_Name = GetItemRequestField{name: "name"}
+)
+
+func EqGetItemRequestIgnoring(v *GetItemRequest, fields ...GetItemRequestField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqGetItemRequestMasked(v *GetItemRequest, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+// ItemField is the name of a field of Item.
+type ItemField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f ItemField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	ItemField_Name       = ItemField{name: "name"}
+	ItemField_Quantity   = ItemField{name: "quantity"}
+	ItemField_Dimensions = ItemField{name: "dimensions"}
+)
+
+func EqItemIgnoring(v *Item, fields ...ItemField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqItemMasked(v *Item, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+// WatchItemsRequestField is the name of a field of WatchItemsRequest.
+type WatchItemsRequestField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f WatchItemsRequestField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	WatchItemsRequestField_Prefix = WatchItemsRequestField{name: "prefix"}
+)
+
+func EqWatchItemsRequestIgnoring(v *WatchItemsRequest, fields ...WatchItemsRequestField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqWatchItemsRequestMasked(v *WatchItemsRequest, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+// ItemEventField is the name of a field of ItemEvent.
+type ItemEventField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f ItemEventField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	ItemEventField_Item    = ItemEventField{name: "item"}
+	ItemEventField_Deleted = ItemEventField{name: "deleted"}
+)
+
+func EqItemEventIgnoring(v *ItemEvent, fields ...ItemEventField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqItemEventMasked(v *ItemEvent, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+// ImportItemsResponseField is the name of a field of ImportItemsResponse.
+type ImportItemsResponseField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f ImportItemsResponseField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	ImportItemsResponseField_Count = ImportItemsResponseField{name: "count"}
+)
+
+func EqImportItemsResponseIgnoring(v *ImportItemsResponse, fields ...ImportItemsResponseField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqImportItemsResponseMasked(v *ImportItemsResponse, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+func EqualGetItemRequest(v *GetItemRequest) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func EqualItem(v *Item) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func EqualWatchItemsRequest(v *WatchItemsRequest) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func EqualItemEvent(v *ItemEvent) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func EqualImportItemsResponse(v *ImportItemsResponse) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func HaveReceivedGetItem(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(testifymock.Received, "GetItem", args...)
+}
+
+func HaveReceivedWatchItems(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(testifymock.Received, "WatchItems", args...)
+}
+
+func HaveReceivedImportItems(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(testifymock.Received, "ImportItems", args...)
+}
@@ -0,0 +1,94 @@
+package editions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+var Table = &Item{
+	Name:       "table",
+	Quantity:   proto.Int32(0),
+	Dimensions: &Item_Dimensions{Width: 120, Height: 75},
+}
+
+func TestGetItem(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// Create a new mock client for the Inventory service.
+	m := NewMockInventoryClient()
+	defer m.AssertExpectations(t)
+
+	// Set up the expectation.
+	ctx := context.Background()
+	req := &GetItemRequest{Name: "table"}
+	m.OnGetItem(ctx, EqGetItemRequest(req)).Return(Table, nil)
+
+	// Call the client.
+	r, err := m.GetItem(ctx, &GetItemRequest{Name: "table"})
+
+	// Check that the response, including the explicit zero quantity, is as expected.
+	require.NoError(t, err)
+	g.Expect(r).To(EqualItem(&Item{
+		Name:       "table",
+		Quantity:   proto.Int32(0),
+		Dimensions: &Item_Dimensions{Width: 120, Height: 75},
+	}))
+	g.Expect(r.Quantity).NotTo(gomega.BeNil())
+	g.Expect(m).To(HaveReceivedGetItem(ctx, req))
+}
+
+func TestWatchItems(t *testing.T) {
+	// Create a new mock client for the Inventory service.
+	m := NewMockInventoryClient()
+	defer m.AssertExpectations(t)
+
+	// Create the stream, which returns an event with a delimited item.
+	ctx := context.Background()
+	req := &WatchItemsRequest{Prefix: "t"}
+	res := NewMockInventory_WatchItemsClient()
+	defer res.AssertExpectations(t)
+	res.OnRecv().Return(&ItemEvent{Item: Table}, nil)
+
+	// Set up the expectation.
+	m.OnWatchItems(ctx, req).Return(res, nil)
+
+	// Call the client and use the streaming handler.
+	r, err := m.WatchItems(ctx, req)
+	require.NoError(t, err)
+	e, err := r.Recv()
+	require.NoError(t, err)
+
+	// Check that the delimited item survives a round trip in the wire format.
+	b, err := proto.Marshal(e)
+	require.NoError(t, err)
+	got := &ItemEvent{}
+	require.NoError(t, proto.Unmarshal(b, got))
+	assert.True(t, proto.Equal(Table, got.GetItem()))
+}
+
+func TestImportItems(t *testing.T) {
+	// Create a new mock server for the Inventory service and its stream.
+	m := NewMockInventoryServer()
+	defer m.AssertExpectations(t)
+	stream := NewMockInventory_ImportItemsServer()
+	defer stream.AssertExpectations(t)
+
+	// Set up the expectations.
+	stream.OnRecv().Return(Table, nil)
+	stream.OnSendAndClose(EqImportItemsResponse(&ImportItemsResponse{Count: 1})).Return(nil)
+	m.OnImportItems(stream).Return(nil).Run(func(args mock.Arguments) {
+		s := args.Get(0).(Inventory_ImportItemsServer)
+		if _, err := s.Recv(); err == nil {
+			_ = s.SendAndClose(&ImportItemsResponse{Count: 1})
+		}
+	})
+
+	// Call the server.
+	assert.NoError(t, m.ImportItems(stream))
+}
@@ -9,18 +9,26 @@
 
 import (
 	context "context"
+	grpcmock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+	gomegamock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/gomegamock"
+	pegomockmock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/pegomockmock"
+	types "github.com/onsi/gomega/types"
 	pegomock "github.com/petergtz/pegomock"
 	grpc "google.golang.org/grpc"
-	"reflect"
-	"time"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	time "time"
 )
 
 type MockGreeterClient struct {
-	fail func(message string, callerSkip ...int)
+	fail        func(message string, callerSkip ...int)
+	invocations pegomockmock.Invocations
 }
 
 func NewMockGreeterClient(options ...pegomock.Option) *MockGreeterClient {
 	mock := &MockGreeterClient{}
+	pegomockmock.TrackCoverage("helloworld.Greeter", []string{"SayHello"}, mock, &mock.invocations)
 	for _, option := range options {
 		option.Apply(mock)
 	}
@@ -38,6 +46,7 @@ func (mock *MockGreeterClient) SayHello(ctx context.Context, in *HelloRequest, o
 	for _, param := range opts {
 		params = append(params, param)
 	}
+	mock.invocations.Invoked("SayHello", params)
 	result := pegomock.GetGenericMockFrom(mock).Invoke("SayHello", params, []reflect.Type{reflect.TypeOf((**HelloReply)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
 	var ret0 *HelloReply
 	var ret1 error
@@ -94,6 +103,7 @@ func (verifier *VerifierMockGreeterClient) SayHello(ctx context.Context, in *Hel
 	for _, param := range opts {
 		params = append(params, param)
 	}
+	pegomockmock.ResetMatchers()
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SayHello", params, verifier.timeout)
 	return &MockGreeterClient_SayHello_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
 }
@@ -133,11 +143,14 @@ func (c *MockGreeterClient_SayHello_OngoingVerification) GetAllCapturedArguments
 }
 
 type MockGreeterServer struct {
-	fail func(message string, callerSkip ...int)
+	UnimplementedGreeterServer
+	fail        func(message string, callerSkip ...int)
+	invocations pegomockmock.Invocations
 }
 
 func NewMockGreeterServer(options ...pegomock.Option) *MockGreeterServer {
 	mock := &MockGreeterServer{}
+	pegomockmock.TrackCoverage("helloworld.Greeter", []string{"SayHello"}, mock, &mock.invocations)
 	for _, option := range options {
 		option.Apply(mock)
 	}
@@ -152,6 +165,7 @@ func (mock *MockGreeterServer) SayHello(ctx context.Context, in *HelloRequest) (
 		panic("mock must not be nil. Use myMock := NewMockGreeterServer().")
 	}
 	params := []pegomock.Param{ctx, in}
+	mock.invocations.Invoked("SayHello", params)
 	result := pegomock.GetGenericMockFrom(mock).Invoke("SayHello", params, []reflect.Type{reflect.TypeOf((**HelloReply)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
 	var ret0 *HelloReply
 	var ret1 error
@@ -205,6 +219,7 @@ type VerifierMockGreeterServer struct {
 
 func (verifier *VerifierMockGreeterServer) SayHello(ctx context.Context, in *HelloRequest) *MockGreeterServer_SayHello_OngoingVerification {
 	params := []pegomock.Param{ctx, in}
+	pegomockmock.ResetMatchers()
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SayHello", params, verifier.timeout)
 	return &MockGreeterServer_SayHello_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
 }
@@ -234,50 +249,130 @@ func (c *MockGreeterServer_SayHello_OngoingVerification) GetAllCapturedArguments
 	return
 }
 
-func AnyPtrToHelloworldHelloRequest() *HelloRequest {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(*HelloRequest))(nil)).Elem()))
-	var nullValue *HelloRequest
-	return nullValue
+type interceptedGreeterClient struct {
+	client       GreeterClient
+	interceptors grpcmock.ClientInterceptors
 }
 
-func EqPtrToHelloworldHelloRequest(value *HelloRequest) *HelloRequest {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue *HelloRequest
-	return nullValue
+func NewGreeterClientWithInterceptors(client GreeterClient, interceptors grpcmock.ClientInterceptors) GreeterClient {
+	return &interceptedGreeterClient{client: client, interceptors: interceptors}
 }
 
-func NotEqPtrToHelloworldHelloRequest(value *HelloRequest) *HelloRequest {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue *HelloRequest
-	return nullValue
+func (c *interceptedGreeterClient) SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error) {
+	return grpcmock.InterceptUnaryClient(ctx, c.interceptors.Unary, "/helloworld.Greeter/SayHello", in, opts, c.client.SayHello)
 }
 
-func PtrToHelloworldHelloRequestThat(matcher pegomock.ArgumentMatcher) *HelloRequest {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue *HelloRequest
-	return nullValue
+type interceptedGreeterServer struct {
+	UnimplementedGreeterServer
+	server       GreeterServer
+	interceptors grpcmock.ServerInterceptors
 }
 
+func NewGreeterServerWithInterceptors(server GreeterServer, interceptors grpcmock.ServerInterceptors) GreeterServer {
+	return &interceptedGreeterServer{server: server, interceptors: interceptors}
+}
+
+func (s *interceptedGreeterServer) SayHello(ctx context.Context, in *HelloRequest) (*HelloReply, error) {
+	return grpcmock.InterceptUnaryServer(ctx, s.interceptors.Unary, s.server, "/helloworld.Greeter/SayHello", in, s.server.SayHello)
+}
+
+var (
+	_ GreeterClient = (*MockGreeterClient)(nil)
+	_ GreeterServer = (*MockGreeterServer)(nil)
+)
+
 func AnyPtrToHelloworldHelloReply() *HelloReply {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(*HelloReply))(nil)).Elem()))
-	var nullValue *HelloReply
-	return nullValue
+	return pegomockmock.AnyArg[*HelloReply]()
 }
 
 func EqPtrToHelloworldHelloReply(value *HelloReply) *HelloReply {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue *HelloReply
-	return nullValue
+	return pegomockmock.EqArg[*HelloReply](value)
 }
 
 func NotEqPtrToHelloworldHelloReply(value *HelloReply) *HelloReply {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue *HelloReply
-	return nullValue
+	return pegomockmock.NotEqArg[*HelloReply](value)
 }
 
 func PtrToHelloworldHelloReplyThat(matcher pegomock.ArgumentMatcher) *HelloReply {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue *HelloReply
-	return nullValue
+	return pegomockmock.MatchArg[*HelloReply](matcher)
+}
+
+func AnyPtrToHelloworldHelloRequest() *HelloRequest {
+	return pegomockmock.AnyArg[*HelloRequest]()
+}
+
+func EqPtrToHelloworldHelloRequest(value *HelloRequest) *HelloRequest {
+	return pegomockmock.EqArg[*HelloRequest](value)
+}
+
+func NotEqPtrToHelloworldHelloRequest(value *HelloRequest) *HelloRequest {
+	return pegomockmock.NotEqArg[*HelloRequest](value)
+}
+
+func PtrToHelloworldHelloRequestThat(matcher pegomock.ArgumentMatcher) *HelloRequest {
+	return pegomockmock.MatchArg[*HelloRequest](matcher)
+}
+
+func CaptureHelloRequest() *pegomockmock.Captor[HelloRequest] {
+	return pegomockmock.NewCaptor[HelloRequest]()
+}
+
+func CaptureHelloReply() *pegomockmock.Captor[HelloReply] {
+	return pegomockmock.NewCaptor[HelloReply]()
+}
+
+// HelloRequestField is the name of a field of HelloRequest.
+type HelloRequestField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f HelloRequestField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	HelloRequestField_Name = HelloRequestField{name: "name"}
+)
+
+func EqHelloRequestIgnoring(v *HelloRequest, fields ...HelloRequestField) *HelloRequest {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqHelloRequestMasked(v *HelloRequest, mask *fieldmaskpb.FieldMask) *HelloRequest {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+// HelloReplyField is the name of a field of HelloReply.
+type HelloReplyField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f HelloReplyField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	HelloReplyField_Message = HelloReplyField{name: "message"}
+)
+
+func EqHelloReplyIgnoring(v *HelloReply, fields ...HelloReplyField) *HelloReply {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqHelloReplyMasked(v *HelloReply, mask *fieldmaskpb.FieldMask) *HelloReply {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+func EqualHelloRequest(v *HelloRequest) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func EqualHelloReply(v *HelloReply) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func HaveReceivedSayHello(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(pegomockmock.Received, "SayHello", args...)
 }
@@ -9,24 +9,46 @@
 
 import (
 	context "context"
+	grpcmock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+	gomegamock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/gomegamock"
+	testifymock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/testifymock"
+	types "github.com/onsi/gomega/types"
 	mock "github.com/stretchr/testify/mock"
+	suite "github.com/stretchr/testify/suite"
 	grpc "google.golang.org/grpc"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func AnyHelloRequest() mock.AnythingOfTypeArgument {
 	return mock.AnythingOfType("*helloworld.HelloRequest")
 }
 
+func EqHelloRequest(v *HelloRequest) interface{} {
+	return testifymock.EqMessage(v)
+}
+
 func AnyHelloReply() mock.AnythingOfTypeArgument {
 	return mock.AnythingOfType("*helloworld.HelloReply")
 }
 
+func EqHelloReply(v *HelloReply) interface{} {
+	return testifymock.EqMessage(v)
+}
+
 type MockGreeterClient struct {
 	mock.Mock
+	expectations testifymock.Expectations
+}
+
+func (m *MockGreeterClient) On(method string, args ...interface{}) *mock.Call {
+	return testifymock.On(&m.Mock, &m.expectations, method, args...)
 }
 
 func NewMockGreeterClient() *MockGreeterClient {
-	return &MockGreeterClient{}
+	m := &MockGreeterClient{}
+	testifymock.TrackCoverage("helloworld.Greeter", []string{"SayHello"}, &m.expectations)
+	return m
 }
 
 func (c *MockGreeterClient) SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error) {
@@ -34,27 +56,162 @@ func (c *MockGreeterClient) SayHello(ctx context.Context, in *HelloRequest, opts
 	for _, opts1 := range opts {
 		opts0 = append(opts0, opts1)
 	}
-	args := c.Called(opts0...)
+	args := testifymock.Called(&c.Mock, &c.expectations, "SayHello", opts0...)
 	return args.Get(0).(*HelloReply), args.Error(1)
 }
 
 func (c *MockGreeterClient) OnSayHello(ctx interface{}, in interface{}, opts ...interface{}) *mock.Call {
-	return c.On("SayHello", append([]interface{}{ctx, in}, opts...)...)
+	return testifymock.On(&c.Mock, &c.expectations, "SayHello", append([]interface{}{ctx, in}, opts...)...)
 }
 
 type MockGreeterServer struct {
 	mock.Mock
+	UnimplementedGreeterServer
+	expectations testifymock.Expectations
+}
+
+func (m *MockGreeterServer) On(method string, args ...interface{}) *mock.Call {
+	return testifymock.On(&m.Mock, &m.expectations, method, args...)
 }
 
 func NewMockGreeterServer() *MockGreeterServer {
-	return &MockGreeterServer{}
+	m := &MockGreeterServer{}
+	testifymock.TrackCoverage("helloworld.Greeter", []string{"SayHello"}, &m.expectations)
+	return m
 }
 
 func (s *MockGreeterServer) SayHello(ctx context.Context, in *HelloRequest) (*HelloReply, error) {
-	args := s.Called(ctx, in)
+	args := testifymock.Called(&s.Mock, &s.expectations, "SayHello", ctx, in)
 	return args.Get(0).(*HelloReply), args.Error(1)
 }
 
 func (s *MockGreeterServer) OnSayHello(ctx interface{}, in interface{}) *mock.Call {
-	return s.On("SayHello", ctx, in)
+	return testifymock.On(&s.Mock, &s.expectations, "SayHello", ctx, in)
+}
+
+type interceptedGreeterClient struct {
+	client       GreeterClient
+	interceptors grpcmock.ClientInterceptors
+}
+
+func NewGreeterClientWithInterceptors(client GreeterClient, interceptors grpcmock.ClientInterceptors) GreeterClient {
+	return &interceptedGreeterClient{client: client, interceptors: interceptors}
+}
+
+func (c *interceptedGreeterClient) SayHello(ctx context.Context, in *HelloRequest, opts ...grpc.CallOption) (*HelloReply, error) {
+	return grpcmock.InterceptUnaryClient(ctx, c.interceptors.Unary, "/helloworld.Greeter/SayHello", in, opts, c.client.SayHello)
+}
+
+type interceptedGreeterServer struct {
+	UnimplementedGreeterServer
+	server       GreeterServer
+	interceptors grpcmock.ServerInterceptors
+}
+
+func NewGreeterServerWithInterceptors(server GreeterServer, interceptors grpcmock.ServerInterceptors) GreeterServer {
+	return &interceptedGreeterServer{server: server, interceptors: interceptors}
+}
+
+func (s *interceptedGreeterServer) SayHello(ctx context.Context, in *HelloRequest) (*HelloReply, error) {
+	return grpcmock.InterceptUnaryServer(ctx, s.interceptors.Unary, s.server, "/helloworld.Greeter/SayHello", in, s.server.SayHello)
+}
+
+var (
+	_ GreeterClient = (*MockGreeterClient)(nil)
+	_ GreeterServer = (*MockGreeterServer)(nil)
+)
+
+// GreeterMockSuite is a testify suite providing fresh mocks of the Greeter service for each test.
+// The client mock returns the stream mocks of the suite, when the streaming methods are stubbed.
+type GreeterMockSuite struct {
+	suite.Suite
+
+	Client *MockGreeterClient
+	Server *MockGreeterServer
+}
+
+func (s *GreeterMockSuite) SetupTest() {
+	s.Client = NewMockGreeterClient()
+	testifymock.Test(&s.Client.Mock, s.T())
+	s.Server = NewMockGreeterServer()
+	testifymock.Test(&s.Server.Mock, s.T())
+}
+
+func (s *GreeterMockSuite) TearDownTest() {
+	s.Client.AssertExpectations(s.T())
+	s.Server.AssertExpectations(s.T())
+}
+
+// StubSayHello stubs the SayHello method of the client mock with any context.
+func (s *GreeterMockSuite) StubSayHello(in interface{}, out *HelloReply, err error) *mock.Call {
+	return s.Client.OnSayHello(mock.Anything, in).Return(out, err)
+}
+
+// StubServerSayHello stubs the SayHello method of the server mock with any context.
+func (s *GreeterMockSuite) StubServerSayHello(in interface{}, out *HelloReply, err error) *mock.Call {
+	return s.Server.OnSayHello(mock.Anything, in).Return(out, err)
+}
+
+func CaptureHelloRequest() *testifymock.Captor[HelloRequest] {
+	return testifymock.NewCaptor[HelloRequest]()
+}
+
+func CaptureHelloReply() *testifymock.Captor[HelloReply] {
+	return testifymock.NewCaptor[HelloReply]()
+}
+
+// HelloRequestField is the name of a field of HelloRequest.
+type HelloRequestField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f HelloRequestField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	HelloRequestField_Name = HelloRequestField{name: "name"}
+)
+
+func EqHelloRequestIgnoring(v *HelloRequest, fields ...HelloRequestField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqHelloRequestMasked(v *HelloRequest, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+// HelloReplyField is the name of a field of HelloReply.
+type HelloReplyField struct {
+	name protoreflect.Name
+}
+
+// FieldName returns the name of the field.
+func (f HelloReplyField) FieldName() protoreflect.Name {
+	return f.name
+}
+
+var (
+	HelloReplyField_Message = HelloReplyField{name: "message"}
+)
+
+func EqHelloReplyIgnoring(v *HelloReply, fields ...HelloReplyField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
+}
+
+func EqHelloReplyMasked(v *HelloReply, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
+}
+
+func EqualHelloRequest(v *HelloRequest) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func EqualHelloReply(v *HelloReply) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
+}
+
+func HaveReceivedSayHello(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(testifymock.Received, "SayHello", args...)
 }
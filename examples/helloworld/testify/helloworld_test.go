@@ -2,11 +2,19 @@
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"google.golang.org/grpc"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/testifymock"
 )
 
 func TestSayHello(t *testing.T) {
@@ -71,3 +79,155 @@ func TestSayHelloWithAnyOptions(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, res, r)
 }
+
+func TestSayHelloMismatch(t *testing.T) {
+	// Create a new mock client, which expects a request for Alice.
+	m := NewMockGreeterClient()
+	m.OnSayHello(mock.Anything, EqHelloRequest(&HelloRequest{Name: "Alice"})).Return(&HelloReply{}, nil)
+
+	// Call the client with a request for Bob.
+	var msg string
+	func() {
+		defer func() { msg, _ = recover().(string) }()
+		_, _ = m.SayHello(context.Background(), &HelloRequest{Name: "Bob"})
+	}()
+
+	// Check that the failure contains the diff of the requests.
+	assert.Contains(t, msg, "Diff of argument 1 (-expected +actual)")
+	assert.Contains(t, msg, `"Alice"`)
+	assert.Contains(t, msg, `"Bob"`)
+}
+
+func TestSayHelloMismatchMatchedBy(t *testing.T) {
+	// Create a new mock client with a matcher, which only accepts requests.
+	m := NewMockGreeterClient()
+	m.OnSayHello(mock.Anything, mock.MatchedBy(func(x interface{}) bool {
+		return x.(*HelloRequest).GetName() == "Alice"
+	})).Return(&HelloReply{}, nil)
+
+	// Call the client with a request for Bob.
+	var msg string
+	func() {
+		defer func() { msg, _ = recover().(string) }()
+		_, _ = m.SayHello(context.Background(), &HelloRequest{Name: "Bob"})
+	}()
+
+	// Check that the failure is reported without a diff and without calling the
+	// matcher with anything but the request.
+	assert.Contains(t, msg, "mock: Unexpected Method Call")
+	assert.NotContains(t, msg, "Diff of argument")
+}
+
+func TestSayHelloConcurrentExpectations(t *testing.T) {
+	// Create a new mock client, which is called while expectations are set.
+	m := NewMockGreeterClient()
+	m.OnSayHello(mock.Anything, EqHelloRequest(&HelloRequest{Name: "Alice"})).Return(&HelloReply{}, nil)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			m.OnSayHello(mock.Anything, EqHelloRequest(&HelloRequest{Name: fmt.Sprint(i)})).Return(&HelloReply{}, nil)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		_, err := m.SayHello(context.Background(), &HelloRequest{Name: "Alice"})
+		assert.NoError(t, err)
+	}
+	<-done
+}
+
+func TestSayHelloMismatchTest(t *testing.T) {
+	// Create a new mock client, which reports failures to the recorder.
+	m := NewMockGreeterClient()
+	rec := &recorder{}
+	testifymock.Test(&m.Mock, rec)
+	m.OnSayHello(mock.Anything, &HelloRequest{Name: "Alice"}).Return(&HelloReply{}, nil)
+
+	// Call the client with a request for Bob on another goroutine, which is stopped by FailNow.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = m.SayHello(context.Background(), &HelloRequest{Name: "Bob"})
+	}()
+	<-done
+
+	// Check that the failure contains the diff of the requests.
+	assert.Len(t, rec.errors, 1)
+	assert.Contains(t, rec.errors[0], "Diff of argument 1 (-expected +actual)")
+	assert.True(t, rec.failed)
+}
+
+func TestSayHelloOverCalledTest(t *testing.T) {
+	// Create a new mock client, which expects a single call and reports failures to the recorder.
+	m := NewMockGreeterClient()
+	rec := &recorder{}
+	testifymock.Test(&m.Mock, rec)
+	m.OnSayHello(mock.Anything, EqHelloRequest(&HelloRequest{Name: "Alice"})).Return(&HelloReply{}, nil).Once()
+
+	// Call the client twice on another goroutine, which is stopped by FailNow.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = m.SayHello(context.Background(), &HelloRequest{Name: "Alice"})
+		_, _ = m.SayHello(context.Background(), &HelloRequest{Name: "Alice"})
+	}()
+	<-done
+
+	// Check that the failure of testify is reported to the recorder as well.
+	assert.Len(t, rec.errors, 1)
+	assert.Contains(t, rec.errors[0], "has been called over 1 times")
+	assert.True(t, rec.failed)
+}
+
+// recorder records the failures reported to a mock.TestingT.
+type recorder struct {
+	errors []string
+	failed bool
+}
+
+func (r *recorder) Logf(string, ...interface{}) {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) FailNow() {
+	r.failed = true
+	runtime.Goexit()
+}
+
+// runFunc runs the tests of RunWithCoverage as a function.
+type runFunc func() int
+
+func (f runFunc) Run() int { return f() }
+
+func TestCoverage(t *testing.T) {
+	// Create a new mock client, stub the method twice and call it twice with the coverage enabled.
+	dir := t.TempDir()
+	code := grpcmock.RunWithCoverage(runFunc(func() int {
+		m := NewMockGreeterClient()
+		m.OnSayHello(mock.Anything, AnyHelloRequest()).Return(&HelloReply{}, nil)
+		m.OnSayHello(mock.Anything, EqHelloRequest(&HelloRequest{Name: "Alice"})).Return(&HelloReply{}, nil).Maybe()
+		_, _ = m.SayHello(context.Background(), &HelloRequest{})
+		_, _ = m.SayHello(context.Background(), &HelloRequest{})
+		m.AssertExpectations(t)
+		return 0
+	}), dir)
+	assert.Zero(t, code)
+
+	// Check that the stubs and the calls are reported in the JSON report with
+	// the stub, which matched no call.
+	data, err := os.ReadFile(filepath.Join(dir, grpcmock.CoverageJSONFile))
+	assert.NoError(t, err)
+
+	var report grpcmock.CoverageReport
+	assert.NoError(t, json.Unmarshal(data, &report))
+	assert.Equal(t, []grpcmock.ServiceCoverage{{
+		Service: "helloworld.Greeter",
+		Methods: []grpcmock.MethodCoverage{{
+			Method: "SayHello", Stubbed: 2, Called: 2, Status: grpcmock.MethodCalled,
+			Unmatched: []string{`SayHello(mock.Anything, Eq(*helloworld.HelloRequest{name:"Alice"}))`},
+		}},
+	}}, report.Services)
+}
@@ -0,0 +1,60 @@
+package helloworld
+
+import (
+	"context"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/petergtz/pegomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func AnyContextContext() context.Context {
+	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(context.Context))(nil)).Elem()))
+	var nullValue context.Context
+	return nullValue
+}
+
+func TestSayHello(t *testing.T) {
+	// Create a new mock for the Greeter service.
+	m := NewMockGreeter(pegomock.WithT(t))
+
+	// Create the request and response.
+	ctx := context.Background()
+	req := &HelloRequest{Name: "Felix"}
+	res := &HelloReply{Message: "Hello, world!"}
+
+	// Set up the expectation.
+	pegomock.When(m.SayHello(AnyContextContext(), EqPtrToHelloworldHelloRequest(req))).ThenReturn(res, nil)
+
+	// Call the mock.
+	r, err := m.SayHello(ctx, req)
+
+	// Check that the response is as expected.
+	assert.NoError(t, err)
+	assert.Equal(t, res, r)
+}
+
+func TestSayHelloServer(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// Create a new mock for the Greeter service and serve it.
+	m := NewMockGreeter(pegomock.WithT(t))
+	srv := httptest.NewServer(NewGreeterServer(m))
+	defer srv.Close()
+
+	// Set up the expectation.
+	pegomock.When(m.SayHello(AnyContextContext(), AnyPtrToHelloworldHelloRequest())).ThenReturn(&HelloReply{Message: "Hello, world!"}, nil)
+
+	// Call the server with a twirp client.
+	client := NewGreeterProtobufClient(srv.URL, srv.Client())
+	r, err := client.SayHello(context.Background(), &HelloRequest{Name: "Felix"})
+
+	// Check the response and verify the received request.
+	require.NoError(t, err)
+	g.Expect(r).To(EqualHelloReply(&HelloReply{Message: "Hello, world!"}))
+	m.VerifyWasCalledOnce().SayHello(AnyContextContext(), AnyPtrToHelloworldHelloRequest())
+}
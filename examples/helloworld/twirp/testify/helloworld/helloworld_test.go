@@ -0,0 +1,55 @@
+package helloworld
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSayHello(t *testing.T) {
+	// Create a new mock for the Greeter service.
+	m := NewMockGreeter()
+	defer m.AssertExpectations(t)
+
+	// Create the request and response.
+	ctx := context.Background()
+	req := &HelloRequest{Name: "Felix"}
+	res := &HelloReply{Message: "Hello, world!"}
+
+	// Set up the expectation.
+	m.OnSayHello(ctx, EqHelloRequest(req)).Return(res, nil)
+
+	// Call the mock.
+	r, err := m.SayHello(ctx, &HelloRequest{Name: "Felix"})
+
+	// Check that the response is as expected.
+	assert.NoError(t, err)
+	assert.Equal(t, res, r)
+}
+
+func TestSayHelloServer(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// Create a new mock for the Greeter service and serve it.
+	m := NewMockGreeter()
+	defer m.AssertExpectations(t)
+	srv := httptest.NewServer(NewGreeterServer(m))
+	defer srv.Close()
+
+	// Set up the expectation.
+	m.OnSayHello(mock.Anything, AnyHelloRequest()).Return(&HelloReply{Message: "Hello, world!"}, nil)
+
+	// Call the server with a twirp client.
+	client := NewGreeterProtobufClient(srv.URL, srv.Client())
+	r, err := client.SayHello(context.Background(), &HelloRequest{Name: "Felix"})
+
+	// Check the response and verify the received request.
+	require.NoError(t, err)
+	g.Expect(r).To(EqualHelloReply(&HelloReply{Message: "Hello, world!"}))
+	g.Expect(m).To(HaveReceivedSayHello(mock.Anything, EqHelloRequest(&HelloRequest{Name: "Felix"})))
+}
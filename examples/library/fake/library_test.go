@@ -0,0 +1,126 @@
+package library
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestCreateAndGetBook(t *testing.T) {
+	// Create a new fake server with a shelf.
+	s := NewFakeLibraryServer()
+	s.Shelves.Put(&Shelf{Name: "shelves/fiction"})
+	ctx := context.Background()
+
+	// Create a book with a generated and a given ID.
+	b1, err := s.CreateBook(ctx, &CreateBookRequest{Parent: "shelves/fiction", Book: &Book{Title: "Dune"}})
+	require.NoError(t, err)
+	assert.Equal(t, "shelves/fiction/books/1", b1.GetName())
+
+	b2, err := s.CreateBook(ctx, &CreateBookRequest{Parent: "shelves/fiction", Book: &Book{Title: "Emma"}, BookId: "emma"})
+	require.NoError(t, err)
+	assert.Equal(t, "shelves/fiction/books/emma", b2.GetName())
+
+	// Get the book.
+	got, err := s.GetBook(ctx, &GetBookRequest{Name: "shelves/fiction/books/emma"})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(b2, got))
+
+	// Creating the same book again fails.
+	_, err = s.CreateBook(ctx, &CreateBookRequest{Parent: "shelves/fiction", Book: &Book{Title: "Emma"}, BookId: "emma"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestGetBookNotFound(t *testing.T) {
+	s := NewFakeLibraryServer()
+
+	_, err := s.GetBook(context.Background(), &GetBookRequest{Name: "shelves/1/books/1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListBooksPagination(t *testing.T) {
+	// Create a new fake server with books on two shelves.
+	s := NewFakeLibraryServer()
+	s.Books.Put(
+		&Book{Name: "shelves/1/books/a"},
+		&Book{Name: "shelves/1/books/b"},
+		&Book{Name: "shelves/1/books/c"},
+		&Book{Name: "shelves/2/books/d"},
+	)
+	ctx := context.Background()
+
+	// List the first page.
+	res, err := s.ListBooks(ctx, &ListBooksRequest{Parent: "shelves/1", PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, res.GetBooks(), 2)
+	assert.Equal(t, "shelves/1/books/a", res.GetBooks()[0].GetName())
+	assert.Equal(t, "shelves/1/books/b", res.GetBooks()[1].GetName())
+	require.NotEmpty(t, res.GetNextPageToken())
+
+	// List the last page.
+	res, err = s.ListBooks(ctx, &ListBooksRequest{Parent: "shelves/1", PageSize: 2, PageToken: res.GetNextPageToken()})
+	require.NoError(t, err)
+	require.Len(t, res.GetBooks(), 1)
+	assert.Equal(t, "shelves/1/books/c", res.GetBooks()[0].GetName())
+	assert.Empty(t, res.GetNextPageToken())
+
+	// An invalid page token fails.
+	_, err = s.ListBooks(ctx, &ListBooksRequest{Parent: "shelves/1", PageToken: "%invalid%"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUpdateBookWithFieldMask(t *testing.T) {
+	s := NewFakeLibraryServer()
+	s.Books.Put(&Book{Name: "shelves/1/books/1", Title: "Dune", Author: "Frank Herbert"})
+	ctx := context.Background()
+
+	// Only the masked fields are updated.
+	b, err := s.UpdateBook(ctx, &UpdateBookRequest{
+		Book:       &Book{Name: "shelves/1/books/1", Title: "Dune Messiah", Author: "unknown", Read: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"title", "read"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Dune Messiah", b.GetTitle())
+	assert.Equal(t, "Frank Herbert", b.GetAuthor())
+	assert.True(t, b.GetRead())
+
+	// Unknown fields in the mask fail.
+	_, err = s.UpdateBook(ctx, &UpdateBookRequest{
+		Book:       &Book{Name: "shelves/1/books/1"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"isbn"}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Missing books cannot be updated.
+	_, err = s.UpdateBook(ctx, &UpdateBookRequest{Book: &Book{Name: "shelves/1/books/2"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDeleteShelf(t *testing.T) {
+	s := NewFakeLibraryServer()
+	ctx := context.Background()
+
+	shelf, err := s.CreateShelf(ctx, &CreateShelfRequest{Shelf: &Shelf{Theme: "Poetry"}})
+	require.NoError(t, err)
+	assert.Equal(t, "shelves/1", shelf.GetName())
+
+	_, err = s.DeleteShelf(ctx, &DeleteShelfRequest{Name: shelf.GetName()})
+	require.NoError(t, err)
+	assert.Equal(t, 0, s.Shelves.Len())
+
+	_, err = s.DeleteShelf(ctx, &DeleteShelfRequest{Name: shelf.GetName()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCustomMethodUnimplemented(t *testing.T) {
+	s := NewFakeLibraryServer()
+
+	_, err := s.MoveBook(context.Background(), &MoveBookRequest{Name: "shelves/1/books/1"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
@@ -0,0 +1,68 @@
+package routeguideconnect
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/onsi/gomega"
+	"github.com/petergtz/pegomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	routeguide "github.com/lovoo/protoc-gen-go-grpcmock/examples/routeguide/connect/pegomock"
+)
+
+var DresdenCenter = &routeguide.Point{Latitude: 510504090, Longitude: 137372620}
+
+func AnyContextContext() context.Context {
+	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(context.Context))(nil)).Elem()))
+	var nullValue context.Context
+	return nullValue
+}
+
+func TestGetFeature(t *testing.T) {
+	// Create a new mock client for the RouteGuide service.
+	m := NewMockRouteGuideClient(pegomock.WithT(t))
+
+	// Create the response.
+	ctx := context.Background()
+	res := connect.NewResponse(&routeguide.Feature{Name: "Dresden", Location: DresdenCenter})
+
+	// Set up the expectation, matching the message of the request.
+	pegomock.When(m.GetFeature(AnyContextContext(), EqRequestPoint(DresdenCenter))).ThenReturn(res, nil)
+
+	// Call the client.
+	r, err := m.GetFeature(ctx, connect.NewRequest(&routeguide.Point{Latitude: 510504090, Longitude: 137372620}))
+
+	// Check that the response is as expected.
+	assert.NoError(t, err)
+	assert.Equal(t, "Dresden", r.Msg.GetName())
+}
+
+func TestGetFeatureHandler(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// Create a new mock handler for the RouteGuide service and serve it.
+	m := NewMockRouteGuideHandler(pegomock.WithT(t))
+	mux := http.NewServeMux()
+	mux.Handle(NewRouteGuideHandler(m))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	// Set up the expectation.
+	res := connect.NewResponse(&routeguide.Feature{Name: "Dresden", Location: DresdenCenter})
+	pegomock.When(m.GetFeature(AnyContextContext(), AnyRequestPoint())).ThenReturn(res, nil)
+
+	// Call the handler with a connect client.
+	client := NewRouteGuideClient(srv.Client(), srv.URL)
+	r, err := client.GetFeature(context.Background(), connect.NewRequest(DresdenCenter))
+
+	// Check the response and verify the received request.
+	require.NoError(t, err)
+	g.Expect(r.Msg).To(EqualFeature(&routeguide.Feature{Name: "Dresden", Location: DresdenCenter}))
+	m.VerifyWasCalledOnce().GetFeature(AnyContextContext(), EqRequestPoint(DresdenCenter))
+}
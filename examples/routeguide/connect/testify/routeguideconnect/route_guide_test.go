@@ -0,0 +1,71 @@
+package routeguideconnect
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	routeguide "github.com/lovoo/protoc-gen-go-grpcmock/examples/routeguide/connect/testify"
+)
+
+var DresdenCenter = &routeguide.Point{Latitude: 510504090, Longitude: 137372620}
+
+func TestGetFeature(t *testing.T) {
+	// Create a new mock client for the RouteGuide service.
+	m := NewMockRouteGuideClient()
+	defer m.AssertExpectations(t)
+
+	// Create the response.
+	ctx := context.Background()
+	res := connect.NewResponse(&routeguide.Feature{Name: "Dresden", Location: DresdenCenter})
+
+	// Set up the expectation, matching the message of the request.
+	m.OnGetFeature(ctx, EqRequestPoint(DresdenCenter)).Return(res, nil)
+
+	// Call the client.
+	r, err := m.GetFeature(ctx, connect.NewRequest(&routeguide.Point{Latitude: 510504090, Longitude: 137372620}))
+
+	// Check that the response is as expected.
+	assert.NoError(t, err)
+	assert.Equal(t, "Dresden", r.Msg.GetName())
+}
+
+func TestGetFeatureHandler(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// Create a new mock handler for the RouteGuide service and serve it.
+	m := NewMockRouteGuideHandler()
+	defer m.AssertExpectations(t)
+	mux := http.NewServeMux()
+	mux.Handle(NewRouteGuideHandler(m))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	// Set up the expectation.
+	res := connect.NewResponse(&routeguide.Feature{Name: "Dresden", Location: DresdenCenter})
+	m.OnGetFeature(mock.Anything, AnyRequestPoint()).Return(res, nil)
+
+	// Call the handler with a connect client.
+	client := NewRouteGuideClient(srv.Client(), srv.URL)
+	r, err := client.GetFeature(context.Background(), connect.NewRequest(DresdenCenter))
+
+	// Check the response and the received request.
+	require.NoError(t, err)
+	g.Expect(r.Msg).To(EqualFeature(&routeguide.Feature{Name: "Dresden", Location: DresdenCenter}))
+	g.Expect(m).To(HaveReceivedGetFeature(mock.Anything, EqRequestPoint(DresdenCenter)))
+}
+
+func TestRouteChatHandlerUnimplemented(t *testing.T) {
+	// The mock handler embeds the unimplemented handler.
+	m := NewMockRouteGuideHandler()
+
+	err := m.UnimplementedRouteGuideHandler.RouteChat(context.Background(), nil)
+	assert.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+}
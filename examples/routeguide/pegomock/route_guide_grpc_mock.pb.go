@@ -9,19 +9,27 @@
 
 import (
 	context "context"
+	grpcmock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+	gomegamock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/gomegamock"
+	pegomockmock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/pegomockmock"
+	types "github.com/onsi/gomega/types"
 	pegomock "github.com/petergtz/pegomock"
 	grpc "google.golang.org/grpc"
 	metadata "google.golang.org/grpc/metadata"
-	"reflect"
-	"time"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	reflect "reflect"
+	time "time"
 )
 
 type MockRouteGuideClient struct {
-	fail func(message string, callerSkip ...int)
+	fail        func(message string, callerSkip ...int)
+	invocations pegomockmock.Invocations
 }
 
 func NewMockRouteGuideClient(options ...pegomock.Option) *MockRouteGuideClient {
 	mock := &MockRouteGuideClient{}
+	pegomockmock.TrackCoverage("routeguide.RouteGuide", []string{"GetFeature", "ListFeatures", "RecordRoute", "RouteChat"}, mock, &mock.invocations)
 	for _, option := range options {
 		option.Apply(mock)
 	}
@@ -39,6 +47,7 @@ func (mock *MockRouteGuideClient) GetFeature(ctx context.Context, in *Point, opt
 	for _, param := range opts {
 		params = append(params, param)
 	}
+	mock.invocations.Invoked("GetFeature", params)
 	result := pegomock.GetGenericMockFrom(mock).Invoke("GetFeature", params, []reflect.Type{reflect.TypeOf((**Feature)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
 	var ret0 *Feature
 	var ret1 error
@@ -61,6 +70,7 @@ func (mock *MockRouteGuideClient) ListFeatures(ctx context.Context, in *Rectangl
 	for _, param := range opts {
 		params = append(params, param)
 	}
+	mock.invocations.Invoked("ListFeatures", params)
 	result := pegomock.GetGenericMockFrom(mock).Invoke("ListFeatures", params, []reflect.Type{reflect.TypeOf((*RouteGuide_ListFeaturesClient)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
 	var ret0 RouteGuide_ListFeaturesClient
 	var ret1 error
@@ -83,6 +93,7 @@ func (mock *MockRouteGuideClient) RecordRoute(ctx context.Context, opts ...grpc.
 	for _, param := range opts {
 		params = append(params, param)
 	}
+	mock.invocations.Invoked("RecordRoute", params)
 	result := pegomock.GetGenericMockFrom(mock).Invoke("RecordRoute", params, []reflect.Type{reflect.TypeOf((*RouteGuide_RecordRouteClient)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
 	var ret0 RouteGuide_RecordRouteClient
 	var ret1 error
@@ -105,6 +116,7 @@ func (mock *MockRouteGuideClient) RouteChat(ctx context.Context, opts ...grpc.Ca
 	for _, param := range opts {
 		params = append(params, param)
 	}
+	mock.invocations.Invoked("RouteChat", params)
 	result := pegomock.GetGenericMockFrom(mock).Invoke("RouteChat", params, []reflect.Type{reflect.TypeOf((*RouteGuide_RouteChatClient)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
 	var ret0 RouteGuide_RouteChatClient
 	var ret1 error
@@ -161,6 +173,7 @@ func (verifier *VerifierMockRouteGuideClient) GetFeature(ctx context.Context, in
 	for _, param := range opts {
 		params = append(params, param)
 	}
+	pegomockmock.ResetMatchers()
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "GetFeature", params, verifier.timeout)
 	return &MockRouteGuideClient_GetFeature_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
 }
@@ -204,6 +217,7 @@ func (verifier *VerifierMockRouteGuideClient) ListFeatures(ctx context.Context,
 	for _, param := range opts {
 		params = append(params, param)
 	}
+	pegomockmock.ResetMatchers()
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "ListFeatures", params, verifier.timeout)
 	return &MockRouteGuideClient_ListFeatures_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
 }
@@ -247,6 +261,7 @@ func (verifier *VerifierMockRouteGuideClient) RecordRoute(ctx context.Context, o
 	for _, param := range opts {
 		params = append(params, param)
 	}
+	pegomockmock.ResetMatchers()
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "RecordRoute", params, verifier.timeout)
 	return &MockRouteGuideClient_RecordRoute_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
 }
@@ -286,6 +301,7 @@ func (verifier *VerifierMockRouteGuideClient) RouteChat(ctx context.Context, opt
 	for _, param := range opts {
 		params = append(params, param)
 	}
+	pegomockmock.ResetMatchers()
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "RouteChat", params, verifier.timeout)
 	return &MockRouteGuideClient_RouteChat_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
 }
@@ -321,11 +337,14 @@ func (c *MockRouteGuideClient_RouteChat_OngoingVerification) GetAllCapturedArgum
 }
 
 type MockRouteGuideServer struct {
-	fail func(message string, callerSkip ...int)
+	UnimplementedRouteGuideServer
+	fail        func(message string, callerSkip ...int)
+	invocations pegomockmock.Invocations
 }
 
 func NewMockRouteGuideServer(options ...pegomock.Option) *MockRouteGuideServer {
 	mock := &MockRouteGuideServer{}
+	pegomockmock.TrackCoverage("routeguide.RouteGuide", []string{"GetFeature", "ListFeatures", "RecordRoute", "RouteChat"}, mock, &mock.invocations)
 	for _, option := range options {
 		option.Apply(mock)
 	}
@@ -340,6 +359,7 @@ func (mock *MockRouteGuideServer) GetFeature(ctx context.Context, in *Point) (*F
 		panic("mock must not be nil. Use myMock := NewMockRouteGuideServer().")
 	}
 	params := []pegomock.Param{ctx, in}
+	mock.invocations.Invoked("GetFeature", params)
 	result := pegomock.GetGenericMockFrom(mock).Invoke("GetFeature", params, []reflect.Type{reflect.TypeOf((**Feature)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
 	var ret0 *Feature
 	var ret1 error
@@ -359,6 +379,7 @@ func (mock *MockRouteGuideServer) ListFeatures(in *Rectangle, out RouteGuide_Lis
 		panic("mock must not be nil. Use myMock := NewMockRouteGuideServer().")
 	}
 	params := []pegomock.Param{in, out}
+	mock.invocations.Invoked("ListFeatures", params)
 	result := pegomock.GetGenericMockFrom(mock).Invoke("ListFeatures", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
 	var ret0 error
 	if len(result) != 0 {
@@ -374,6 +395,7 @@ func (mock *MockRouteGuideServer) RecordRoute(out RouteGuide_RecordRouteServer)
 		panic("mock must not be nil. Use myMock := NewMockRouteGuideServer().")
 	}
 	params := []pegomock.Param{out}
+	mock.invocations.Invoked("RecordRoute", params)
 	result := pegomock.GetGenericMockFrom(mock).Invoke("RecordRoute", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
 	var ret0 error
 	if len(result) != 0 {
@@ -389,6 +411,7 @@ func (mock *MockRouteGuideServer) RouteChat(out RouteGuide_RouteChatServer) erro
 		panic("mock must not be nil. Use myMock := NewMockRouteGuideServer().")
 	}
 	params := []pegomock.Param{out}
+	mock.invocations.Invoked("RouteChat", params)
 	result := pegomock.GetGenericMockFrom(mock).Invoke("RouteChat", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
 	var ret0 error
 	if len(result) != 0 {
@@ -438,6 +461,7 @@ type VerifierMockRouteGuideServer struct {
 
 func (verifier *VerifierMockRouteGuideServer) GetFeature(ctx context.Context, in *Point) *MockRouteGuideServer_GetFeature_OngoingVerification {
 	params := []pegomock.Param{ctx, in}
+	pegomockmock.ResetMatchers()
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "GetFeature", params, verifier.timeout)
 	return &MockRouteGuideServer_GetFeature_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
 }
@@ -469,6 +493,7 @@ func (c *MockRouteGuideServer_GetFeature_OngoingVerification) GetAllCapturedArgu
 
 func (verifier *VerifierMockRouteGuideServer) ListFeatures(in *Rectangle, out RouteGuide_ListFeaturesServer) *MockRouteGuideServer_ListFeatures_OngoingVerification {
 	params := []pegomock.Param{in, out}
+	pegomockmock.ResetMatchers()
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "ListFeatures", params, verifier.timeout)
 	return &MockRouteGuideServer_ListFeatures_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
 }
@@ -500,6 +525,7 @@ func (c *MockRouteGuideServer_ListFeatures_OngoingVerification) GetAllCapturedAr
 
 func (verifier *VerifierMockRouteGuideServer) RecordRoute(out RouteGuide_RecordRouteServer) *MockRouteGuideServer_RecordRoute_OngoingVerification {
 	params := []pegomock.Param{out}
+	pegomockmock.ResetMatchers()
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "RecordRoute", params, verifier.timeout)
 	return &MockRouteGuideServer_RecordRoute_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
 }
@@ -527,6 +553,7 @@ func (c *MockRouteGuideServer_RecordRoute_OngoingVerification) GetAllCapturedArg
 
 func (verifier *VerifierMockRouteGuideServer) RouteChat(out RouteGuide_RouteChatServer) *MockRouteGuideServer_RouteChat_OngoingVerification {
 	params := []pegomock.Param{out}
+	pegomockmock.ResetMatchers()
 	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "RouteChat", params, verifier.timeout)
 	return &MockRouteGuideServer_RouteChat_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
 }
@@ -553,7 +580,7 @@ func (c *MockRouteGuideServer_RouteChat_OngoingVerification) GetAllCapturedArgum
 }
 
 type MockRouteGuide_ListFeaturesClient struct {
-	fail func(message string, callerSkip ...int)
+	pegomockmock.ServerStreamingClient[Feature]
 }
 
 func NewMockRouteGuide_ListFeaturesClient(options ...pegomock.Option) *MockRouteGuide_ListFeaturesClient {
@@ -564,302 +591,8 @@ func NewMockRouteGuide_ListFeaturesClient(options ...pegomock.Option) *MockRoute
 	return mock
 }
 
-func (mock *MockRouteGuide_ListFeaturesClient) SetFailHandler(fh pegomock.FailHandler) {
-	mock.fail = fh
-}
-func (mock *MockRouteGuide_ListFeaturesClient) FailHandler() pegomock.FailHandler { return mock.fail }
-
-func (mock *MockRouteGuide_ListFeaturesClient) Header() (metadata.MD, error) {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Header", params, []reflect.Type{reflect.TypeOf((*metadata.MD)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 metadata.MD
-	var ret1 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(metadata.MD)
-		}
-		if result[1] != nil {
-			ret1 = result[1].(error)
-		}
-	}
-	return ret0, ret1
-}
-
-func (mock *MockRouteGuide_ListFeaturesClient) Trailer() metadata.MD {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Trailer", params, []reflect.Type{reflect.TypeOf((*metadata.MD)(nil)).Elem()})
-	var ret0 metadata.MD
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(metadata.MD)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_ListFeaturesClient) CloseSend() error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("CloseSend", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_ListFeaturesClient) Context() context.Context {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Context", params, []reflect.Type{reflect.TypeOf((*context.Context)(nil)).Elem()})
-	var ret0 context.Context
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(context.Context)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_ListFeaturesClient) SendMsg(msg interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesClient().")
-	}
-	params := []pegomock.Param{msg}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SendMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_ListFeaturesClient) RecvMsg(msg interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesClient().")
-	}
-	params := []pegomock.Param{msg}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("RecvMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_ListFeaturesClient) Recv() (*Feature, error) {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Recv", params, []reflect.Type{reflect.TypeOf((**Feature)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 *Feature
-	var ret1 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(*Feature)
-		}
-		if result[1] != nil {
-			ret1 = result[1].(error)
-		}
-	}
-	return ret0, ret1
-}
-
-func (mock *MockRouteGuide_ListFeaturesClient) VerifyWasCalledOnce() *VerifierMockRouteGuide_ListFeaturesClient {
-	return &VerifierMockRouteGuide_ListFeaturesClient{
-		mock:                   mock,
-		invocationCountMatcher: pegomock.Times(1),
-	}
-}
-
-func (mock *MockRouteGuide_ListFeaturesClient) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *VerifierMockRouteGuide_ListFeaturesClient {
-	return &VerifierMockRouteGuide_ListFeaturesClient{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-	}
-}
-
-func (mock *MockRouteGuide_ListFeaturesClient) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *VerifierMockRouteGuide_ListFeaturesClient {
-	return &VerifierMockRouteGuide_ListFeaturesClient{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		inOrderContext:         inOrderContext,
-	}
-}
-
-func (mock *MockRouteGuide_ListFeaturesClient) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *VerifierMockRouteGuide_ListFeaturesClient {
-	return &VerifierMockRouteGuide_ListFeaturesClient{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		timeout:                timeout,
-	}
-}
-
-type VerifierMockRouteGuide_ListFeaturesClient struct {
-	mock                   *MockRouteGuide_ListFeaturesClient
-	invocationCountMatcher pegomock.InvocationCountMatcher
-	inOrderContext         *pegomock.InOrderContext
-	timeout                time.Duration
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesClient) Header() *MockRouteGuide_ListFeaturesClient_Header_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Header", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesClient_Header_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesClient_Header_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_Header_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_Header_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesClient) Trailer() *MockRouteGuide_ListFeaturesClient_Trailer_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Trailer", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesClient_Trailer_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesClient_Trailer_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_Trailer_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_Trailer_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesClient) CloseSend() *MockRouteGuide_ListFeaturesClient_CloseSend_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "CloseSend", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesClient_CloseSend_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesClient_CloseSend_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_CloseSend_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_CloseSend_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesClient) Context() *MockRouteGuide_ListFeaturesClient_Context_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Context", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesClient_Context_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesClient_Context_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_Context_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_Context_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesClient) SendMsg(msg interface{}) *MockRouteGuide_ListFeaturesClient_SendMsg_OngoingVerification {
-	params := []pegomock.Param{msg}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SendMsg", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesClient_SendMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesClient_SendMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_SendMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	msg := c.GetAllCapturedArguments()
-	return msg[len(msg)-1]
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_SendMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesClient) RecvMsg(msg interface{}) *MockRouteGuide_ListFeaturesClient_RecvMsg_OngoingVerification {
-	params := []pegomock.Param{msg}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "RecvMsg", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesClient_RecvMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesClient_RecvMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_RecvMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	msg := c.GetAllCapturedArguments()
-	return msg[len(msg)-1]
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_RecvMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesClient) Recv() *MockRouteGuide_ListFeaturesClient_Recv_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Recv", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesClient_Recv_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesClient_Recv_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_Recv_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_ListFeaturesClient_Recv_OngoingVerification) GetAllCapturedArguments() {
-}
-
 type MockRouteGuide_ListFeaturesServer struct {
-	fail func(message string, callerSkip ...int)
+	pegomockmock.ServerStreamingServer[Feature]
 }
 
 func NewMockRouteGuide_ListFeaturesServer(options ...pegomock.Option) *MockRouteGuide_ListFeaturesServer {
@@ -870,2031 +603,534 @@ func NewMockRouteGuide_ListFeaturesServer(options ...pegomock.Option) *MockRoute
 	return mock
 }
 
-func (mock *MockRouteGuide_ListFeaturesServer) SetFailHandler(fh pegomock.FailHandler) {
-	mock.fail = fh
-}
-func (mock *MockRouteGuide_ListFeaturesServer) FailHandler() pegomock.FailHandler { return mock.fail }
-
-func (mock *MockRouteGuide_ListFeaturesServer) SetHeader(md metadata.MD) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesServer().")
-	}
-	params := []pegomock.Param{md}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SetHeader", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_ListFeaturesServer) SendHeader(md metadata.MD) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesServer().")
-	}
-	params := []pegomock.Param{md}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SendHeader", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
+type MockRouteGuide_RecordRouteClient struct {
+	pegomockmock.ClientStreamingClient[Point, RouteSummary]
 }
 
-func (mock *MockRouteGuide_ListFeaturesServer) SetTrailer(md metadata.MD) {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesServer().")
+func NewMockRouteGuide_RecordRouteClient(options ...pegomock.Option) *MockRouteGuide_RecordRouteClient {
+	mock := &MockRouteGuide_RecordRouteClient{}
+	for _, option := range options {
+		option.Apply(mock)
 	}
-	params := []pegomock.Param{md}
-	pegomock.GetGenericMockFrom(mock).Invoke("SetTrailer", params, []reflect.Type{})
+	return mock
 }
 
-func (mock *MockRouteGuide_ListFeaturesServer) Context() context.Context {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesServer().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Context", params, []reflect.Type{reflect.TypeOf((*context.Context)(nil)).Elem()})
-	var ret0 context.Context
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(context.Context)
-		}
-	}
-	return ret0
+type MockRouteGuide_RecordRouteServer struct {
+	pegomockmock.ClientStreamingServer[Point, RouteSummary]
 }
 
-func (mock *MockRouteGuide_ListFeaturesServer) SendMsg(m interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesServer().")
-	}
-	params := []pegomock.Param{m}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SendMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
+func NewMockRouteGuide_RecordRouteServer(options ...pegomock.Option) *MockRouteGuide_RecordRouteServer {
+	mock := &MockRouteGuide_RecordRouteServer{}
+	for _, option := range options {
+		option.Apply(mock)
 	}
-	return ret0
+	return mock
 }
 
-func (mock *MockRouteGuide_ListFeaturesServer) RecvMsg(m interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesServer().")
-	}
-	params := []pegomock.Param{m}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("RecvMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
+type MockRouteGuide_RouteChatClient struct {
+	pegomockmock.BidiStreamingClient[RouteNote, RouteNote]
 }
 
-func (mock *MockRouteGuide_ListFeaturesServer) Send(m *Feature) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_ListFeaturesServer().")
-	}
-	params := []pegomock.Param{m}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Send", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
+func NewMockRouteGuide_RouteChatClient(options ...pegomock.Option) *MockRouteGuide_RouteChatClient {
+	mock := &MockRouteGuide_RouteChatClient{}
+	for _, option := range options {
+		option.Apply(mock)
 	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_ListFeaturesServer) VerifyWasCalledOnce() *VerifierMockRouteGuide_ListFeaturesServer {
-	return &VerifierMockRouteGuide_ListFeaturesServer{
-		mock:                   mock,
-		invocationCountMatcher: pegomock.Times(1),
-	}
-}
-
-func (mock *MockRouteGuide_ListFeaturesServer) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *VerifierMockRouteGuide_ListFeaturesServer {
-	return &VerifierMockRouteGuide_ListFeaturesServer{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-	}
-}
-
-func (mock *MockRouteGuide_ListFeaturesServer) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *VerifierMockRouteGuide_ListFeaturesServer {
-	return &VerifierMockRouteGuide_ListFeaturesServer{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		inOrderContext:         inOrderContext,
-	}
-}
-
-func (mock *MockRouteGuide_ListFeaturesServer) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *VerifierMockRouteGuide_ListFeaturesServer {
-	return &VerifierMockRouteGuide_ListFeaturesServer{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		timeout:                timeout,
-	}
-}
-
-type VerifierMockRouteGuide_ListFeaturesServer struct {
-	mock                   *MockRouteGuide_ListFeaturesServer
-	invocationCountMatcher pegomock.InvocationCountMatcher
-	inOrderContext         *pegomock.InOrderContext
-	timeout                time.Duration
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesServer) SetHeader(md metadata.MD) *MockRouteGuide_ListFeaturesServer_SetHeader_OngoingVerification {
-	params := []pegomock.Param{md}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SetHeader", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesServer_SetHeader_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesServer_SetHeader_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_SetHeader_OngoingVerification) GetCapturedArguments() metadata.MD {
-	md := c.GetAllCapturedArguments()
-	return md[len(md)-1]
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_SetHeader_OngoingVerification) GetAllCapturedArguments() (_param0 []metadata.MD) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]metadata.MD, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(metadata.MD)
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesServer) SendHeader(md metadata.MD) *MockRouteGuide_ListFeaturesServer_SendHeader_OngoingVerification {
-	params := []pegomock.Param{md}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SendHeader", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesServer_SendHeader_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesServer_SendHeader_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_SendHeader_OngoingVerification) GetCapturedArguments() metadata.MD {
-	md := c.GetAllCapturedArguments()
-	return md[len(md)-1]
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_SendHeader_OngoingVerification) GetAllCapturedArguments() (_param0 []metadata.MD) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]metadata.MD, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(metadata.MD)
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesServer) SetTrailer(md metadata.MD) *MockRouteGuide_ListFeaturesServer_SetTrailer_OngoingVerification {
-	params := []pegomock.Param{md}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SetTrailer", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesServer_SetTrailer_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesServer_SetTrailer_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_SetTrailer_OngoingVerification) GetCapturedArguments() metadata.MD {
-	md := c.GetAllCapturedArguments()
-	return md[len(md)-1]
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_SetTrailer_OngoingVerification) GetAllCapturedArguments() (_param0 []metadata.MD) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]metadata.MD, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(metadata.MD)
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesServer) Context() *MockRouteGuide_ListFeaturesServer_Context_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Context", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesServer_Context_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesServer_Context_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_Context_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_Context_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesServer) SendMsg(m interface{}) *MockRouteGuide_ListFeaturesServer_SendMsg_OngoingVerification {
-	params := []pegomock.Param{m}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SendMsg", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesServer_SendMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesServer_SendMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_SendMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	m := c.GetAllCapturedArguments()
-	return m[len(m)-1]
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_SendMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesServer) RecvMsg(m interface{}) *MockRouteGuide_ListFeaturesServer_RecvMsg_OngoingVerification {
-	params := []pegomock.Param{m}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "RecvMsg", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesServer_RecvMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesServer_RecvMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_RecvMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	m := c.GetAllCapturedArguments()
-	return m[len(m)-1]
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_RecvMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_ListFeaturesServer) Send(m *Feature) *MockRouteGuide_ListFeaturesServer_Send_OngoingVerification {
-	params := []pegomock.Param{m}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Send", params, verifier.timeout)
-	return &MockRouteGuide_ListFeaturesServer_Send_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_ListFeaturesServer_Send_OngoingVerification struct {
-	mock              *MockRouteGuide_ListFeaturesServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_Send_OngoingVerification) GetCapturedArguments() *Feature {
-	m := c.GetAllCapturedArguments()
-	return m[len(m)-1]
-}
-
-func (c *MockRouteGuide_ListFeaturesServer_Send_OngoingVerification) GetAllCapturedArguments() (_param0 []*Feature) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]*Feature, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(*Feature)
-		}
-	}
-	return
-}
-
-type MockRouteGuide_RecordRouteClient struct {
-	fail func(message string, callerSkip ...int)
-}
-
-func NewMockRouteGuide_RecordRouteClient(options ...pegomock.Option) *MockRouteGuide_RecordRouteClient {
-	mock := &MockRouteGuide_RecordRouteClient{}
-	for _, option := range options {
-		option.Apply(mock)
-	}
-	return mock
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) SetFailHandler(fh pegomock.FailHandler) { mock.fail = fh }
-func (mock *MockRouteGuide_RecordRouteClient) FailHandler() pegomock.FailHandler      { return mock.fail }
-
-func (mock *MockRouteGuide_RecordRouteClient) Header() (metadata.MD, error) {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Header", params, []reflect.Type{reflect.TypeOf((*metadata.MD)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 metadata.MD
-	var ret1 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(metadata.MD)
-		}
-		if result[1] != nil {
-			ret1 = result[1].(error)
-		}
-	}
-	return ret0, ret1
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) Trailer() metadata.MD {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Trailer", params, []reflect.Type{reflect.TypeOf((*metadata.MD)(nil)).Elem()})
-	var ret0 metadata.MD
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(metadata.MD)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) CloseSend() error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("CloseSend", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) Context() context.Context {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Context", params, []reflect.Type{reflect.TypeOf((*context.Context)(nil)).Elem()})
-	var ret0 context.Context
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(context.Context)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) SendMsg(msg interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteClient().")
-	}
-	params := []pegomock.Param{msg}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SendMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) RecvMsg(msg interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteClient().")
-	}
-	params := []pegomock.Param{msg}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("RecvMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) Send(m *Point) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteClient().")
-	}
-	params := []pegomock.Param{m}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Send", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) CloseAndRecv() (*RouteSummary, error) {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("CloseAndRecv", params, []reflect.Type{reflect.TypeOf((**RouteSummary)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 *RouteSummary
-	var ret1 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(*RouteSummary)
-		}
-		if result[1] != nil {
-			ret1 = result[1].(error)
-		}
-	}
-	return ret0, ret1
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) VerifyWasCalledOnce() *VerifierMockRouteGuide_RecordRouteClient {
-	return &VerifierMockRouteGuide_RecordRouteClient{
-		mock:                   mock,
-		invocationCountMatcher: pegomock.Times(1),
-	}
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *VerifierMockRouteGuide_RecordRouteClient {
-	return &VerifierMockRouteGuide_RecordRouteClient{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-	}
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *VerifierMockRouteGuide_RecordRouteClient {
-	return &VerifierMockRouteGuide_RecordRouteClient{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		inOrderContext:         inOrderContext,
-	}
-}
-
-func (mock *MockRouteGuide_RecordRouteClient) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *VerifierMockRouteGuide_RecordRouteClient {
-	return &VerifierMockRouteGuide_RecordRouteClient{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		timeout:                timeout,
-	}
-}
-
-type VerifierMockRouteGuide_RecordRouteClient struct {
-	mock                   *MockRouteGuide_RecordRouteClient
-	invocationCountMatcher pegomock.InvocationCountMatcher
-	inOrderContext         *pegomock.InOrderContext
-	timeout                time.Duration
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteClient) Header() *MockRouteGuide_RecordRouteClient_Header_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Header", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteClient_Header_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteClient_Header_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteClient_Header_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_RecordRouteClient_Header_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteClient) Trailer() *MockRouteGuide_RecordRouteClient_Trailer_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Trailer", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteClient_Trailer_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteClient_Trailer_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteClient_Trailer_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_RecordRouteClient_Trailer_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteClient) CloseSend() *MockRouteGuide_RecordRouteClient_CloseSend_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "CloseSend", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteClient_CloseSend_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteClient_CloseSend_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteClient_CloseSend_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_RecordRouteClient_CloseSend_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteClient) Context() *MockRouteGuide_RecordRouteClient_Context_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Context", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteClient_Context_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteClient_Context_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteClient_Context_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_RecordRouteClient_Context_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteClient) SendMsg(msg interface{}) *MockRouteGuide_RecordRouteClient_SendMsg_OngoingVerification {
-	params := []pegomock.Param{msg}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SendMsg", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteClient_SendMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteClient_SendMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteClient_SendMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	msg := c.GetAllCapturedArguments()
-	return msg[len(msg)-1]
-}
-
-func (c *MockRouteGuide_RecordRouteClient_SendMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteClient) RecvMsg(msg interface{}) *MockRouteGuide_RecordRouteClient_RecvMsg_OngoingVerification {
-	params := []pegomock.Param{msg}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "RecvMsg", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteClient_RecvMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteClient_RecvMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteClient_RecvMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	msg := c.GetAllCapturedArguments()
-	return msg[len(msg)-1]
-}
-
-func (c *MockRouteGuide_RecordRouteClient_RecvMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteClient) Send(m *Point) *MockRouteGuide_RecordRouteClient_Send_OngoingVerification {
-	params := []pegomock.Param{m}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Send", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteClient_Send_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteClient_Send_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteClient_Send_OngoingVerification) GetCapturedArguments() *Point {
-	m := c.GetAllCapturedArguments()
-	return m[len(m)-1]
-}
-
-func (c *MockRouteGuide_RecordRouteClient_Send_OngoingVerification) GetAllCapturedArguments() (_param0 []*Point) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]*Point, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(*Point)
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteClient) CloseAndRecv() *MockRouteGuide_RecordRouteClient_CloseAndRecv_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "CloseAndRecv", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteClient_CloseAndRecv_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteClient_CloseAndRecv_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteClient_CloseAndRecv_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_RecordRouteClient_CloseAndRecv_OngoingVerification) GetAllCapturedArguments() {
-}
-
-type MockRouteGuide_RecordRouteServer struct {
-	fail func(message string, callerSkip ...int)
-}
-
-func NewMockRouteGuide_RecordRouteServer(options ...pegomock.Option) *MockRouteGuide_RecordRouteServer {
-	mock := &MockRouteGuide_RecordRouteServer{}
-	for _, option := range options {
-		option.Apply(mock)
-	}
-	return mock
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) SetFailHandler(fh pegomock.FailHandler) { mock.fail = fh }
-func (mock *MockRouteGuide_RecordRouteServer) FailHandler() pegomock.FailHandler      { return mock.fail }
-
-func (mock *MockRouteGuide_RecordRouteServer) SetHeader(md metadata.MD) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteServer().")
-	}
-	params := []pegomock.Param{md}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SetHeader", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) SendHeader(md metadata.MD) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteServer().")
-	}
-	params := []pegomock.Param{md}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SendHeader", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) SetTrailer(md metadata.MD) {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteServer().")
-	}
-	params := []pegomock.Param{md}
-	pegomock.GetGenericMockFrom(mock).Invoke("SetTrailer", params, []reflect.Type{})
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) Context() context.Context {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteServer().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Context", params, []reflect.Type{reflect.TypeOf((*context.Context)(nil)).Elem()})
-	var ret0 context.Context
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(context.Context)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) SendMsg(m interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteServer().")
-	}
-	params := []pegomock.Param{m}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SendMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) RecvMsg(m interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteServer().")
-	}
-	params := []pegomock.Param{m}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("RecvMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) Recv() (*Point, error) {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteServer().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Recv", params, []reflect.Type{reflect.TypeOf((**Point)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 *Point
-	var ret1 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(*Point)
-		}
-		if result[1] != nil {
-			ret1 = result[1].(error)
-		}
-	}
-	return ret0, ret1
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) SendAndClose(m *RouteSummary) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RecordRouteServer().")
-	}
-	params := []pegomock.Param{m}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SendAndClose", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) VerifyWasCalledOnce() *VerifierMockRouteGuide_RecordRouteServer {
-	return &VerifierMockRouteGuide_RecordRouteServer{
-		mock:                   mock,
-		invocationCountMatcher: pegomock.Times(1),
-	}
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *VerifierMockRouteGuide_RecordRouteServer {
-	return &VerifierMockRouteGuide_RecordRouteServer{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-	}
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *VerifierMockRouteGuide_RecordRouteServer {
-	return &VerifierMockRouteGuide_RecordRouteServer{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		inOrderContext:         inOrderContext,
-	}
-}
-
-func (mock *MockRouteGuide_RecordRouteServer) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *VerifierMockRouteGuide_RecordRouteServer {
-	return &VerifierMockRouteGuide_RecordRouteServer{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		timeout:                timeout,
-	}
-}
-
-type VerifierMockRouteGuide_RecordRouteServer struct {
-	mock                   *MockRouteGuide_RecordRouteServer
-	invocationCountMatcher pegomock.InvocationCountMatcher
-	inOrderContext         *pegomock.InOrderContext
-	timeout                time.Duration
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteServer) SetHeader(md metadata.MD) *MockRouteGuide_RecordRouteServer_SetHeader_OngoingVerification {
-	params := []pegomock.Param{md}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SetHeader", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteServer_SetHeader_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteServer_SetHeader_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteServer_SetHeader_OngoingVerification) GetCapturedArguments() metadata.MD {
-	md := c.GetAllCapturedArguments()
-	return md[len(md)-1]
-}
-
-func (c *MockRouteGuide_RecordRouteServer_SetHeader_OngoingVerification) GetAllCapturedArguments() (_param0 []metadata.MD) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]metadata.MD, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(metadata.MD)
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteServer) SendHeader(md metadata.MD) *MockRouteGuide_RecordRouteServer_SendHeader_OngoingVerification {
-	params := []pegomock.Param{md}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SendHeader", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteServer_SendHeader_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteServer_SendHeader_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteServer_SendHeader_OngoingVerification) GetCapturedArguments() metadata.MD {
-	md := c.GetAllCapturedArguments()
-	return md[len(md)-1]
-}
-
-func (c *MockRouteGuide_RecordRouteServer_SendHeader_OngoingVerification) GetAllCapturedArguments() (_param0 []metadata.MD) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]metadata.MD, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(metadata.MD)
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteServer) SetTrailer(md metadata.MD) *MockRouteGuide_RecordRouteServer_SetTrailer_OngoingVerification {
-	params := []pegomock.Param{md}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SetTrailer", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteServer_SetTrailer_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteServer_SetTrailer_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteServer_SetTrailer_OngoingVerification) GetCapturedArguments() metadata.MD {
-	md := c.GetAllCapturedArguments()
-	return md[len(md)-1]
-}
-
-func (c *MockRouteGuide_RecordRouteServer_SetTrailer_OngoingVerification) GetAllCapturedArguments() (_param0 []metadata.MD) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]metadata.MD, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(metadata.MD)
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteServer) Context() *MockRouteGuide_RecordRouteServer_Context_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Context", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteServer_Context_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteServer_Context_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteServer_Context_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_RecordRouteServer_Context_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteServer) SendMsg(m interface{}) *MockRouteGuide_RecordRouteServer_SendMsg_OngoingVerification {
-	params := []pegomock.Param{m}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SendMsg", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteServer_SendMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteServer_SendMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteServer_SendMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	m := c.GetAllCapturedArguments()
-	return m[len(m)-1]
-}
-
-func (c *MockRouteGuide_RecordRouteServer_SendMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteServer) RecvMsg(m interface{}) *MockRouteGuide_RecordRouteServer_RecvMsg_OngoingVerification {
-	params := []pegomock.Param{m}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "RecvMsg", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteServer_RecvMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteServer_RecvMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteServer_RecvMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	m := c.GetAllCapturedArguments()
-	return m[len(m)-1]
-}
-
-func (c *MockRouteGuide_RecordRouteServer_RecvMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteServer) Recv() *MockRouteGuide_RecordRouteServer_Recv_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Recv", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteServer_Recv_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteServer_Recv_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteServer_Recv_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_RecordRouteServer_Recv_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_RecordRouteServer) SendAndClose(m *RouteSummary) *MockRouteGuide_RecordRouteServer_SendAndClose_OngoingVerification {
-	params := []pegomock.Param{m}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SendAndClose", params, verifier.timeout)
-	return &MockRouteGuide_RecordRouteServer_SendAndClose_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RecordRouteServer_SendAndClose_OngoingVerification struct {
-	mock              *MockRouteGuide_RecordRouteServer
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RecordRouteServer_SendAndClose_OngoingVerification) GetCapturedArguments() *RouteSummary {
-	m := c.GetAllCapturedArguments()
-	return m[len(m)-1]
-}
-
-func (c *MockRouteGuide_RecordRouteServer_SendAndClose_OngoingVerification) GetAllCapturedArguments() (_param0 []*RouteSummary) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]*RouteSummary, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(*RouteSummary)
-		}
-	}
-	return
-}
-
-type MockRouteGuide_RouteChatClient struct {
-	fail func(message string, callerSkip ...int)
-}
-
-func NewMockRouteGuide_RouteChatClient(options ...pegomock.Option) *MockRouteGuide_RouteChatClient {
-	mock := &MockRouteGuide_RouteChatClient{}
-	for _, option := range options {
-		option.Apply(mock)
-	}
-	return mock
-}
-
-func (mock *MockRouteGuide_RouteChatClient) SetFailHandler(fh pegomock.FailHandler) { mock.fail = fh }
-func (mock *MockRouteGuide_RouteChatClient) FailHandler() pegomock.FailHandler      { return mock.fail }
-
-func (mock *MockRouteGuide_RouteChatClient) Header() (metadata.MD, error) {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Header", params, []reflect.Type{reflect.TypeOf((*metadata.MD)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 metadata.MD
-	var ret1 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(metadata.MD)
-		}
-		if result[1] != nil {
-			ret1 = result[1].(error)
-		}
-	}
-	return ret0, ret1
-}
-
-func (mock *MockRouteGuide_RouteChatClient) Trailer() metadata.MD {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Trailer", params, []reflect.Type{reflect.TypeOf((*metadata.MD)(nil)).Elem()})
-	var ret0 metadata.MD
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(metadata.MD)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RouteChatClient) CloseSend() error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("CloseSend", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RouteChatClient) Context() context.Context {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Context", params, []reflect.Type{reflect.TypeOf((*context.Context)(nil)).Elem()})
-	var ret0 context.Context
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(context.Context)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RouteChatClient) SendMsg(msg interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatClient().")
-	}
-	params := []pegomock.Param{msg}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SendMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RouteChatClient) RecvMsg(msg interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatClient().")
-	}
-	params := []pegomock.Param{msg}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("RecvMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RouteChatClient) Send(m *RouteNote) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatClient().")
-	}
-	params := []pegomock.Param{m}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Send", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
-}
-
-func (mock *MockRouteGuide_RouteChatClient) Recv() (*RouteNote, error) {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatClient().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Recv", params, []reflect.Type{reflect.TypeOf((**RouteNote)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 *RouteNote
-	var ret1 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(*RouteNote)
-		}
-		if result[1] != nil {
-			ret1 = result[1].(error)
-		}
-	}
-	return ret0, ret1
-}
-
-func (mock *MockRouteGuide_RouteChatClient) VerifyWasCalledOnce() *VerifierMockRouteGuide_RouteChatClient {
-	return &VerifierMockRouteGuide_RouteChatClient{
-		mock:                   mock,
-		invocationCountMatcher: pegomock.Times(1),
-	}
-}
-
-func (mock *MockRouteGuide_RouteChatClient) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *VerifierMockRouteGuide_RouteChatClient {
-	return &VerifierMockRouteGuide_RouteChatClient{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-	}
-}
-
-func (mock *MockRouteGuide_RouteChatClient) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *VerifierMockRouteGuide_RouteChatClient {
-	return &VerifierMockRouteGuide_RouteChatClient{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		inOrderContext:         inOrderContext,
-	}
-}
-
-func (mock *MockRouteGuide_RouteChatClient) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *VerifierMockRouteGuide_RouteChatClient {
-	return &VerifierMockRouteGuide_RouteChatClient{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		timeout:                timeout,
-	}
-}
-
-type VerifierMockRouteGuide_RouteChatClient struct {
-	mock                   *MockRouteGuide_RouteChatClient
-	invocationCountMatcher pegomock.InvocationCountMatcher
-	inOrderContext         *pegomock.InOrderContext
-	timeout                time.Duration
-}
-
-func (verifier *VerifierMockRouteGuide_RouteChatClient) Header() *MockRouteGuide_RouteChatClient_Header_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Header", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatClient_Header_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RouteChatClient_Header_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RouteChatClient_Header_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_RouteChatClient_Header_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_RouteChatClient) Trailer() *MockRouteGuide_RouteChatClient_Trailer_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Trailer", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatClient_Trailer_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RouteChatClient_Trailer_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RouteChatClient_Trailer_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_RouteChatClient_Trailer_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_RouteChatClient) CloseSend() *MockRouteGuide_RouteChatClient_CloseSend_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "CloseSend", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatClient_CloseSend_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RouteChatClient_CloseSend_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RouteChatClient_CloseSend_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_RouteChatClient_CloseSend_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_RouteChatClient) Context() *MockRouteGuide_RouteChatClient_Context_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Context", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatClient_Context_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RouteChatClient_Context_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RouteChatClient_Context_OngoingVerification) GetCapturedArguments() {
-}
-
-func (c *MockRouteGuide_RouteChatClient_Context_OngoingVerification) GetAllCapturedArguments() {
-}
-
-func (verifier *VerifierMockRouteGuide_RouteChatClient) SendMsg(msg interface{}) *MockRouteGuide_RouteChatClient_SendMsg_OngoingVerification {
-	params := []pegomock.Param{msg}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SendMsg", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatClient_SendMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RouteChatClient_SendMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatClient
-	methodInvocations []pegomock.MethodInvocation
-}
-
-func (c *MockRouteGuide_RouteChatClient_SendMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	msg := c.GetAllCapturedArguments()
-	return msg[len(msg)-1]
-}
-
-func (c *MockRouteGuide_RouteChatClient_SendMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
-	}
-	return
-}
-
-func (verifier *VerifierMockRouteGuide_RouteChatClient) RecvMsg(msg interface{}) *MockRouteGuide_RouteChatClient_RecvMsg_OngoingVerification {
-	params := []pegomock.Param{msg}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "RecvMsg", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatClient_RecvMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
-}
-
-type MockRouteGuide_RouteChatClient_RecvMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatClient
-	methodInvocations []pegomock.MethodInvocation
+	return mock
 }
 
-func (c *MockRouteGuide_RouteChatClient_RecvMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	msg := c.GetAllCapturedArguments()
-	return msg[len(msg)-1]
+type MockRouteGuide_RouteChatServer struct {
+	pegomockmock.BidiStreamingServer[RouteNote, RouteNote]
 }
 
-func (c *MockRouteGuide_RouteChatClient_RecvMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
+func NewMockRouteGuide_RouteChatServer(options ...pegomock.Option) *MockRouteGuide_RouteChatServer {
+	mock := &MockRouteGuide_RouteChatServer{}
+	for _, option := range options {
+		option.Apply(mock)
 	}
-	return
+	return mock
 }
 
-func (verifier *VerifierMockRouteGuide_RouteChatClient) Send(m *RouteNote) *MockRouteGuide_RouteChatClient_Send_OngoingVerification {
-	params := []pegomock.Param{m}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Send", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatClient_Send_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+func NewRouteGuide_RouteChatClientScript() *grpcmock.Script[RouteNote, RouteNote] {
+	return grpcmock.NewScript[RouteNote, RouteNote]("/routeguide.RouteGuide/RouteChat")
 }
 
-type MockRouteGuide_RouteChatClient_Send_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatClient
-	methodInvocations []pegomock.MethodInvocation
+func NewRouteGuide_ListFeaturesPipe(ctx context.Context) (*grpcmock.ClientStream[Rectangle, Feature], *grpcmock.ServerStream[Rectangle, Feature]) {
+	return grpcmock.NewPipe[Rectangle, Feature](ctx, "/routeguide.RouteGuide/ListFeatures")
 }
 
-func (c *MockRouteGuide_RouteChatClient_Send_OngoingVerification) GetCapturedArguments() *RouteNote {
-	m := c.GetAllCapturedArguments()
-	return m[len(m)-1]
+func NewRouteGuide_RecordRoutePipe(ctx context.Context) (*grpcmock.ClientStream[Point, RouteSummary], *grpcmock.ServerStream[Point, RouteSummary]) {
+	return grpcmock.NewPipe[Point, RouteSummary](ctx, "/routeguide.RouteGuide/RecordRoute")
 }
 
-func (c *MockRouteGuide_RouteChatClient_Send_OngoingVerification) GetAllCapturedArguments() (_param0 []*RouteNote) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]*RouteNote, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(*RouteNote)
-		}
-	}
-	return
+func NewRouteGuide_RouteChatPipe(ctx context.Context) (*grpcmock.ClientStream[RouteNote, RouteNote], *grpcmock.ServerStream[RouteNote, RouteNote]) {
+	return grpcmock.NewPipe[RouteNote, RouteNote](ctx, "/routeguide.RouteGuide/RouteChat")
 }
 
-func (verifier *VerifierMockRouteGuide_RouteChatClient) Recv() *MockRouteGuide_RouteChatClient_Recv_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Recv", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatClient_Recv_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+type interceptedRouteGuideClient struct {
+	client       RouteGuideClient
+	interceptors grpcmock.ClientInterceptors
 }
 
-type MockRouteGuide_RouteChatClient_Recv_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatClient
-	methodInvocations []pegomock.MethodInvocation
+func NewRouteGuideClientWithInterceptors(client RouteGuideClient, interceptors grpcmock.ClientInterceptors) RouteGuideClient {
+	return &interceptedRouteGuideClient{client: client, interceptors: interceptors}
 }
 
-func (c *MockRouteGuide_RouteChatClient_Recv_OngoingVerification) GetCapturedArguments() {
+func (c *interceptedRouteGuideClient) GetFeature(ctx context.Context, in *Point, opts ...grpc.CallOption) (*Feature, error) {
+	return grpcmock.InterceptUnaryClient(ctx, c.interceptors.Unary, "/routeguide.RouteGuide/GetFeature", in, opts, c.client.GetFeature)
 }
 
-func (c *MockRouteGuide_RouteChatClient_Recv_OngoingVerification) GetAllCapturedArguments() {
+func (c *interceptedRouteGuideClient) ListFeatures(ctx context.Context, in *Rectangle, opts ...grpc.CallOption) (RouteGuide_ListFeaturesClient, error) {
+	return grpcmock.InterceptStreamClient(ctx, c.interceptors.Stream, &grpc.StreamDesc{StreamName: "ListFeatures", ClientStreams: false, ServerStreams: true}, "/routeguide.RouteGuide/ListFeatures", opts,
+		func(ctx context.Context, opts ...grpc.CallOption) (RouteGuide_ListFeaturesClient, error) {
+			return c.client.ListFeatures(ctx, in, opts...)
+		},
+		func(s grpc.ClientStream) RouteGuide_ListFeaturesClient {
+			return &grpcmock.InterceptedClientStream[Rectangle, Feature]{ClientStream: s}
+		})
 }
 
-type MockRouteGuide_RouteChatServer struct {
-	fail func(message string, callerSkip ...int)
+func (c *interceptedRouteGuideClient) RecordRoute(ctx context.Context, opts ...grpc.CallOption) (RouteGuide_RecordRouteClient, error) {
+	return grpcmock.InterceptStreamClient(ctx, c.interceptors.Stream, &grpc.StreamDesc{StreamName: "RecordRoute", ClientStreams: true, ServerStreams: false}, "/routeguide.RouteGuide/RecordRoute", opts,
+		c.client.RecordRoute,
+		func(s grpc.ClientStream) RouteGuide_RecordRouteClient {
+			return &grpcmock.InterceptedClientStream[Point, RouteSummary]{ClientStream: s}
+		})
 }
 
-func NewMockRouteGuide_RouteChatServer(options ...pegomock.Option) *MockRouteGuide_RouteChatServer {
-	mock := &MockRouteGuide_RouteChatServer{}
-	for _, option := range options {
-		option.Apply(mock)
-	}
-	return mock
+func (c *interceptedRouteGuideClient) RouteChat(ctx context.Context, opts ...grpc.CallOption) (RouteGuide_RouteChatClient, error) {
+	return grpcmock.InterceptStreamClient(ctx, c.interceptors.Stream, &grpc.StreamDesc{StreamName: "RouteChat", ClientStreams: true, ServerStreams: true}, "/routeguide.RouteGuide/RouteChat", opts,
+		c.client.RouteChat,
+		func(s grpc.ClientStream) RouteGuide_RouteChatClient {
+			return &grpcmock.InterceptedClientStream[RouteNote, RouteNote]{ClientStream: s}
+		})
 }
 
-func (mock *MockRouteGuide_RouteChatServer) SetFailHandler(fh pegomock.FailHandler) { mock.fail = fh }
-func (mock *MockRouteGuide_RouteChatServer) FailHandler() pegomock.FailHandler      { return mock.fail }
+type interceptedRouteGuideServer struct {
+	UnimplementedRouteGuideServer
+	server       RouteGuideServer
+	interceptors grpcmock.ServerInterceptors
+}
 
-func (mock *MockRouteGuide_RouteChatServer) SetHeader(md metadata.MD) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatServer().")
-	}
-	params := []pegomock.Param{md}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SetHeader", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
+func NewRouteGuideServerWithInterceptors(server RouteGuideServer, interceptors grpcmock.ServerInterceptors) RouteGuideServer {
+	return &interceptedRouteGuideServer{server: server, interceptors: interceptors}
 }
 
-func (mock *MockRouteGuide_RouteChatServer) SendHeader(md metadata.MD) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatServer().")
-	}
-	params := []pegomock.Param{md}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SendHeader", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
+func (s *interceptedRouteGuideServer) GetFeature(ctx context.Context, in *Point) (*Feature, error) {
+	return grpcmock.InterceptUnaryServer(ctx, s.interceptors.Unary, s.server, "/routeguide.RouteGuide/GetFeature", in, s.server.GetFeature)
 }
 
-func (mock *MockRouteGuide_RouteChatServer) SetTrailer(md metadata.MD) {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatServer().")
-	}
-	params := []pegomock.Param{md}
-	pegomock.GetGenericMockFrom(mock).Invoke("SetTrailer", params, []reflect.Type{})
+func (s *interceptedRouteGuideServer) ListFeatures(in *Rectangle, stream RouteGuide_ListFeaturesServer) error {
+	return grpcmock.InterceptStreamServer(s.interceptors.Stream, s.server, &grpc.StreamDesc{StreamName: "ListFeatures", ClientStreams: false, ServerStreams: true}, "/routeguide.RouteGuide/ListFeatures", stream,
+		func(stream RouteGuide_ListFeaturesServer) error {
+			return s.server.ListFeatures(in, stream)
+		},
+		func(ss grpc.ServerStream) RouteGuide_ListFeaturesServer {
+			return &grpcmock.InterceptedServerStream[Rectangle, Feature]{ServerStream: ss}
+		})
 }
 
-func (mock *MockRouteGuide_RouteChatServer) Context() context.Context {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatServer().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Context", params, []reflect.Type{reflect.TypeOf((*context.Context)(nil)).Elem()})
-	var ret0 context.Context
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(context.Context)
-		}
-	}
-	return ret0
+func (s *interceptedRouteGuideServer) RecordRoute(stream RouteGuide_RecordRouteServer) error {
+	return grpcmock.InterceptStreamServer(s.interceptors.Stream, s.server, &grpc.StreamDesc{StreamName: "RecordRoute", ClientStreams: true, ServerStreams: false}, "/routeguide.RouteGuide/RecordRoute", stream,
+		s.server.RecordRoute,
+		func(ss grpc.ServerStream) RouteGuide_RecordRouteServer {
+			return &grpcmock.InterceptedServerStream[Point, RouteSummary]{ServerStream: ss}
+		})
 }
 
-func (mock *MockRouteGuide_RouteChatServer) SendMsg(m interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatServer().")
-	}
-	params := []pegomock.Param{m}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("SendMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
+func (s *interceptedRouteGuideServer) RouteChat(stream RouteGuide_RouteChatServer) error {
+	return grpcmock.InterceptStreamServer(s.interceptors.Stream, s.server, &grpc.StreamDesc{StreamName: "RouteChat", ClientStreams: true, ServerStreams: true}, "/routeguide.RouteGuide/RouteChat", stream,
+		s.server.RouteChat,
+		func(ss grpc.ServerStream) RouteGuide_RouteChatServer {
+			return &grpcmock.InterceptedServerStream[RouteNote, RouteNote]{ServerStream: ss}
+		})
 }
 
-func (mock *MockRouteGuide_RouteChatServer) RecvMsg(m interface{}) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatServer().")
-	}
-	params := []pegomock.Param{m}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("RecvMsg", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
+var (
+	_ RouteGuideClient              = (*MockRouteGuideClient)(nil)
+	_ RouteGuideServer              = (*MockRouteGuideServer)(nil)
+	_ RouteGuide_ListFeaturesClient = (*MockRouteGuide_ListFeaturesClient)(nil)
+	_ RouteGuide_ListFeaturesServer = (*MockRouteGuide_ListFeaturesServer)(nil)
+	_ RouteGuide_ListFeaturesClient = (*grpcmock.ClientStream[Rectangle, Feature])(nil)
+	_ RouteGuide_ListFeaturesServer = (*grpcmock.ServerStream[Rectangle, Feature])(nil)
+	_ RouteGuide_ListFeaturesClient = (*grpcmock.InterceptedClientStream[Rectangle, Feature])(nil)
+	_ RouteGuide_ListFeaturesServer = (*grpcmock.InterceptedServerStream[Rectangle, Feature])(nil)
+	_ RouteGuide_RecordRouteClient  = (*MockRouteGuide_RecordRouteClient)(nil)
+	_ RouteGuide_RecordRouteServer  = (*MockRouteGuide_RecordRouteServer)(nil)
+	_ RouteGuide_RecordRouteClient  = (*grpcmock.ClientStream[Point, RouteSummary])(nil)
+	_ RouteGuide_RecordRouteServer  = (*grpcmock.ServerStream[Point, RouteSummary])(nil)
+	_ RouteGuide_RecordRouteClient  = (*grpcmock.InterceptedClientStream[Point, RouteSummary])(nil)
+	_ RouteGuide_RecordRouteServer  = (*grpcmock.InterceptedServerStream[Point, RouteSummary])(nil)
+	_ RouteGuide_RouteChatClient    = (*MockRouteGuide_RouteChatClient)(nil)
+	_ RouteGuide_RouteChatServer    = (*MockRouteGuide_RouteChatServer)(nil)
+	_ RouteGuide_RouteChatClient    = (*grpcmock.ClientStream[RouteNote, RouteNote])(nil)
+	_ RouteGuide_RouteChatServer    = (*grpcmock.ServerStream[RouteNote, RouteNote])(nil)
+	_ RouteGuide_RouteChatClient    = (*grpcmock.InterceptedClientStream[RouteNote, RouteNote])(nil)
+	_ RouteGuide_RouteChatServer    = (*grpcmock.InterceptedServerStream[RouteNote, RouteNote])(nil)
+	_ RouteGuide_RouteChatClient    = (*grpcmock.Script[RouteNote, RouteNote])(nil)
+)
+
+func AnyMetadataMD() metadata.MD {
+	return pegomockmock.AnyArg[metadata.MD]()
 }
 
-func (mock *MockRouteGuide_RouteChatServer) Recv() (*RouteNote, error) {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatServer().")
-	}
-	params := []pegomock.Param{}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Recv", params, []reflect.Type{reflect.TypeOf((**RouteNote)(nil)).Elem(), reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 *RouteNote
-	var ret1 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(*RouteNote)
-		}
-		if result[1] != nil {
-			ret1 = result[1].(error)
-		}
-	}
-	return ret0, ret1
+func EqMetadataMD(value metadata.MD) metadata.MD {
+	return pegomockmock.EqArg[metadata.MD](value)
 }
 
-func (mock *MockRouteGuide_RouteChatServer) Send(m *RouteNote) error {
-	if mock == nil {
-		panic("mock must not be nil. Use myMock := NewMockRouteGuide_RouteChatServer().")
-	}
-	params := []pegomock.Param{m}
-	result := pegomock.GetGenericMockFrom(mock).Invoke("Send", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
-	var ret0 error
-	if len(result) != 0 {
-		if result[0] != nil {
-			ret0 = result[0].(error)
-		}
-	}
-	return ret0
+func NotEqMetadataMD(value metadata.MD) metadata.MD {
+	return pegomockmock.NotEqArg[metadata.MD](value)
 }
 
-func (mock *MockRouteGuide_RouteChatServer) VerifyWasCalledOnce() *VerifierMockRouteGuide_RouteChatServer {
-	return &VerifierMockRouteGuide_RouteChatServer{
-		mock:                   mock,
-		invocationCountMatcher: pegomock.Times(1),
-	}
+func MetadataMDThat(matcher pegomock.ArgumentMatcher) metadata.MD {
+	return pegomockmock.MatchArg[metadata.MD](matcher)
 }
 
-func (mock *MockRouteGuide_RouteChatServer) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *VerifierMockRouteGuide_RouteChatServer {
-	return &VerifierMockRouteGuide_RouteChatServer{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-	}
+func AnyPtrToRouteguideFeature() *Feature {
+	return pegomockmock.AnyArg[*Feature]()
 }
 
-func (mock *MockRouteGuide_RouteChatServer) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *VerifierMockRouteGuide_RouteChatServer {
-	return &VerifierMockRouteGuide_RouteChatServer{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		inOrderContext:         inOrderContext,
-	}
+func EqPtrToRouteguideFeature(value *Feature) *Feature {
+	return pegomockmock.EqArg[*Feature](value)
 }
 
-func (mock *MockRouteGuide_RouteChatServer) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *VerifierMockRouteGuide_RouteChatServer {
-	return &VerifierMockRouteGuide_RouteChatServer{
-		mock:                   mock,
-		invocationCountMatcher: invocationCountMatcher,
-		timeout:                timeout,
-	}
+func NotEqPtrToRouteguideFeature(value *Feature) *Feature {
+	return pegomockmock.NotEqArg[*Feature](value)
 }
 
-type VerifierMockRouteGuide_RouteChatServer struct {
-	mock                   *MockRouteGuide_RouteChatServer
-	invocationCountMatcher pegomock.InvocationCountMatcher
-	inOrderContext         *pegomock.InOrderContext
-	timeout                time.Duration
+func PtrToRouteguideFeatureThat(matcher pegomock.ArgumentMatcher) *Feature {
+	return pegomockmock.MatchArg[*Feature](matcher)
 }
 
-func (verifier *VerifierMockRouteGuide_RouteChatServer) SetHeader(md metadata.MD) *MockRouteGuide_RouteChatServer_SetHeader_OngoingVerification {
-	params := []pegomock.Param{md}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SetHeader", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatServer_SetHeader_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+func AnyPtrToRouteguidePoint() *Point {
+	return pegomockmock.AnyArg[*Point]()
 }
 
-type MockRouteGuide_RouteChatServer_SetHeader_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatServer
-	methodInvocations []pegomock.MethodInvocation
+func EqPtrToRouteguidePoint(value *Point) *Point {
+	return pegomockmock.EqArg[*Point](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_SetHeader_OngoingVerification) GetCapturedArguments() metadata.MD {
-	md := c.GetAllCapturedArguments()
-	return md[len(md)-1]
+func NotEqPtrToRouteguidePoint(value *Point) *Point {
+	return pegomockmock.NotEqArg[*Point](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_SetHeader_OngoingVerification) GetAllCapturedArguments() (_param0 []metadata.MD) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]metadata.MD, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(metadata.MD)
-		}
-	}
-	return
+func PtrToRouteguidePointThat(matcher pegomock.ArgumentMatcher) *Point {
+	return pegomockmock.MatchArg[*Point](matcher)
 }
 
-func (verifier *VerifierMockRouteGuide_RouteChatServer) SendHeader(md metadata.MD) *MockRouteGuide_RouteChatServer_SendHeader_OngoingVerification {
-	params := []pegomock.Param{md}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SendHeader", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatServer_SendHeader_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+func AnyPtrToRouteguideRectangle() *Rectangle {
+	return pegomockmock.AnyArg[*Rectangle]()
 }
 
-type MockRouteGuide_RouteChatServer_SendHeader_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatServer
-	methodInvocations []pegomock.MethodInvocation
+func EqPtrToRouteguideRectangle(value *Rectangle) *Rectangle {
+	return pegomockmock.EqArg[*Rectangle](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_SendHeader_OngoingVerification) GetCapturedArguments() metadata.MD {
-	md := c.GetAllCapturedArguments()
-	return md[len(md)-1]
+func NotEqPtrToRouteguideRectangle(value *Rectangle) *Rectangle {
+	return pegomockmock.NotEqArg[*Rectangle](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_SendHeader_OngoingVerification) GetAllCapturedArguments() (_param0 []metadata.MD) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]metadata.MD, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(metadata.MD)
-		}
-	}
-	return
+func PtrToRouteguideRectangleThat(matcher pegomock.ArgumentMatcher) *Rectangle {
+	return pegomockmock.MatchArg[*Rectangle](matcher)
 }
 
-func (verifier *VerifierMockRouteGuide_RouteChatServer) SetTrailer(md metadata.MD) *MockRouteGuide_RouteChatServer_SetTrailer_OngoingVerification {
-	params := []pegomock.Param{md}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SetTrailer", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatServer_SetTrailer_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+func AnyPtrToRouteguideRouteNote() *RouteNote {
+	return pegomockmock.AnyArg[*RouteNote]()
 }
 
-type MockRouteGuide_RouteChatServer_SetTrailer_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatServer
-	methodInvocations []pegomock.MethodInvocation
+func EqPtrToRouteguideRouteNote(value *RouteNote) *RouteNote {
+	return pegomockmock.EqArg[*RouteNote](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_SetTrailer_OngoingVerification) GetCapturedArguments() metadata.MD {
-	md := c.GetAllCapturedArguments()
-	return md[len(md)-1]
+func NotEqPtrToRouteguideRouteNote(value *RouteNote) *RouteNote {
+	return pegomockmock.NotEqArg[*RouteNote](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_SetTrailer_OngoingVerification) GetAllCapturedArguments() (_param0 []metadata.MD) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]metadata.MD, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(metadata.MD)
-		}
-	}
-	return
+func PtrToRouteguideRouteNoteThat(matcher pegomock.ArgumentMatcher) *RouteNote {
+	return pegomockmock.MatchArg[*RouteNote](matcher)
 }
 
-func (verifier *VerifierMockRouteGuide_RouteChatServer) Context() *MockRouteGuide_RouteChatServer_Context_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Context", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatServer_Context_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+func AnyPtrToRouteguideRouteSummary() *RouteSummary {
+	return pegomockmock.AnyArg[*RouteSummary]()
 }
 
-type MockRouteGuide_RouteChatServer_Context_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatServer
-	methodInvocations []pegomock.MethodInvocation
+func EqPtrToRouteguideRouteSummary(value *RouteSummary) *RouteSummary {
+	return pegomockmock.EqArg[*RouteSummary](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_Context_OngoingVerification) GetCapturedArguments() {
+func NotEqPtrToRouteguideRouteSummary(value *RouteSummary) *RouteSummary {
+	return pegomockmock.NotEqArg[*RouteSummary](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_Context_OngoingVerification) GetAllCapturedArguments() {
+func PtrToRouteguideRouteSummaryThat(matcher pegomock.ArgumentMatcher) *RouteSummary {
+	return pegomockmock.MatchArg[*RouteSummary](matcher)
 }
 
-func (verifier *VerifierMockRouteGuide_RouteChatServer) SendMsg(m interface{}) *MockRouteGuide_RouteChatServer_SendMsg_OngoingVerification {
-	params := []pegomock.Param{m}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "SendMsg", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatServer_SendMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+func AnyRouteguideRouteGuideListFeaturesClient() RouteGuide_ListFeaturesClient {
+	return pegomockmock.AnyArg[RouteGuide_ListFeaturesClient]()
 }
 
-type MockRouteGuide_RouteChatServer_SendMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatServer
-	methodInvocations []pegomock.MethodInvocation
+func EqRouteguideRouteGuideListFeaturesClient(value RouteGuide_ListFeaturesClient) RouteGuide_ListFeaturesClient {
+	return pegomockmock.EqArg[RouteGuide_ListFeaturesClient](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_SendMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	m := c.GetAllCapturedArguments()
-	return m[len(m)-1]
+func NotEqRouteguideRouteGuideListFeaturesClient(value RouteGuide_ListFeaturesClient) RouteGuide_ListFeaturesClient {
+	return pegomockmock.NotEqArg[RouteGuide_ListFeaturesClient](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_SendMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
-	}
-	return
+func RouteguideRouteGuideListFeaturesClientThat(matcher pegomock.ArgumentMatcher) RouteGuide_ListFeaturesClient {
+	return pegomockmock.MatchArg[RouteGuide_ListFeaturesClient](matcher)
 }
 
-func (verifier *VerifierMockRouteGuide_RouteChatServer) RecvMsg(m interface{}) *MockRouteGuide_RouteChatServer_RecvMsg_OngoingVerification {
-	params := []pegomock.Param{m}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "RecvMsg", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatServer_RecvMsg_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+func AnyRouteguideRouteGuideListFeaturesServer() RouteGuide_ListFeaturesServer {
+	return pegomockmock.AnyArg[RouteGuide_ListFeaturesServer]()
 }
 
-type MockRouteGuide_RouteChatServer_RecvMsg_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatServer
-	methodInvocations []pegomock.MethodInvocation
+func EqRouteguideRouteGuideListFeaturesServer(value RouteGuide_ListFeaturesServer) RouteGuide_ListFeaturesServer {
+	return pegomockmock.EqArg[RouteGuide_ListFeaturesServer](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_RecvMsg_OngoingVerification) GetCapturedArguments() interface{} {
-	m := c.GetAllCapturedArguments()
-	return m[len(m)-1]
+func NotEqRouteguideRouteGuideListFeaturesServer(value RouteGuide_ListFeaturesServer) RouteGuide_ListFeaturesServer {
+	return pegomockmock.NotEqArg[RouteGuide_ListFeaturesServer](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_RecvMsg_OngoingVerification) GetAllCapturedArguments() (_param0 []interface{}) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]interface{}, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(interface{})
-		}
-	}
-	return
+func RouteguideRouteGuideListFeaturesServerThat(matcher pegomock.ArgumentMatcher) RouteGuide_ListFeaturesServer {
+	return pegomockmock.MatchArg[RouteGuide_ListFeaturesServer](matcher)
 }
 
-func (verifier *VerifierMockRouteGuide_RouteChatServer) Recv() *MockRouteGuide_RouteChatServer_Recv_OngoingVerification {
-	params := []pegomock.Param{}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Recv", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatServer_Recv_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+func AnyRouteguideRouteGuideRecordRouteClient() RouteGuide_RecordRouteClient {
+	return pegomockmock.AnyArg[RouteGuide_RecordRouteClient]()
 }
 
-type MockRouteGuide_RouteChatServer_Recv_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatServer
-	methodInvocations []pegomock.MethodInvocation
+func EqRouteguideRouteGuideRecordRouteClient(value RouteGuide_RecordRouteClient) RouteGuide_RecordRouteClient {
+	return pegomockmock.EqArg[RouteGuide_RecordRouteClient](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_Recv_OngoingVerification) GetCapturedArguments() {
+func NotEqRouteguideRouteGuideRecordRouteClient(value RouteGuide_RecordRouteClient) RouteGuide_RecordRouteClient {
+	return pegomockmock.NotEqArg[RouteGuide_RecordRouteClient](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_Recv_OngoingVerification) GetAllCapturedArguments() {
+func RouteguideRouteGuideRecordRouteClientThat(matcher pegomock.ArgumentMatcher) RouteGuide_RecordRouteClient {
+	return pegomockmock.MatchArg[RouteGuide_RecordRouteClient](matcher)
 }
 
-func (verifier *VerifierMockRouteGuide_RouteChatServer) Send(m *RouteNote) *MockRouteGuide_RouteChatServer_Send_OngoingVerification {
-	params := []pegomock.Param{m}
-	methodInvocations := pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, "Send", params, verifier.timeout)
-	return &MockRouteGuide_RouteChatServer_Send_OngoingVerification{mock: verifier.mock, methodInvocations: methodInvocations}
+func AnyRouteguideRouteGuideRecordRouteServer() RouteGuide_RecordRouteServer {
+	return pegomockmock.AnyArg[RouteGuide_RecordRouteServer]()
 }
 
-type MockRouteGuide_RouteChatServer_Send_OngoingVerification struct {
-	mock              *MockRouteGuide_RouteChatServer
-	methodInvocations []pegomock.MethodInvocation
+func EqRouteguideRouteGuideRecordRouteServer(value RouteGuide_RecordRouteServer) RouteGuide_RecordRouteServer {
+	return pegomockmock.EqArg[RouteGuide_RecordRouteServer](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_Send_OngoingVerification) GetCapturedArguments() *RouteNote {
-	m := c.GetAllCapturedArguments()
-	return m[len(m)-1]
+func NotEqRouteguideRouteGuideRecordRouteServer(value RouteGuide_RecordRouteServer) RouteGuide_RecordRouteServer {
+	return pegomockmock.NotEqArg[RouteGuide_RecordRouteServer](value)
 }
 
-func (c *MockRouteGuide_RouteChatServer_Send_OngoingVerification) GetAllCapturedArguments() (_param0 []*RouteNote) {
-	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
-	if len(params) > 0 {
-		_param0 = make([]*RouteNote, len(c.methodInvocations))
-		for u, param := range params[0] {
-			_param0[u] = param.(*RouteNote)
-		}
-	}
-	return
+func RouteguideRouteGuideRecordRouteServerThat(matcher pegomock.ArgumentMatcher) RouteGuide_RecordRouteServer {
+	return pegomockmock.MatchArg[RouteGuide_RecordRouteServer](matcher)
 }
 
-func AnyPtrToRouteguideFeature() *Feature {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(*Feature))(nil)).Elem()))
-	var nullValue *Feature
-	return nullValue
+func AnyRouteguideRouteGuideRouteChatClient() RouteGuide_RouteChatClient {
+	return pegomockmock.AnyArg[RouteGuide_RouteChatClient]()
 }
 
-func EqPtrToRouteguideFeature(value *Feature) *Feature {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue *Feature
-	return nullValue
+func EqRouteguideRouteGuideRouteChatClient(value RouteGuide_RouteChatClient) RouteGuide_RouteChatClient {
+	return pegomockmock.EqArg[RouteGuide_RouteChatClient](value)
 }
 
-func NotEqPtrToRouteguideFeature(value *Feature) *Feature {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue *Feature
-	return nullValue
+func NotEqRouteguideRouteGuideRouteChatClient(value RouteGuide_RouteChatClient) RouteGuide_RouteChatClient {
+	return pegomockmock.NotEqArg[RouteGuide_RouteChatClient](value)
 }
 
-func PtrToRouteguideFeatureThat(matcher pegomock.ArgumentMatcher) *Feature {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue *Feature
-	return nullValue
+func RouteguideRouteGuideRouteChatClientThat(matcher pegomock.ArgumentMatcher) RouteGuide_RouteChatClient {
+	return pegomockmock.MatchArg[RouteGuide_RouteChatClient](matcher)
 }
 
 func AnyRouteguideRouteGuideRouteChatServer() RouteGuide_RouteChatServer {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(RouteGuide_RouteChatServer))(nil)).Elem()))
-	var nullValue RouteGuide_RouteChatServer
-	return nullValue
+	return pegomockmock.AnyArg[RouteGuide_RouteChatServer]()
 }
 
 func EqRouteguideRouteGuideRouteChatServer(value RouteGuide_RouteChatServer) RouteGuide_RouteChatServer {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue RouteGuide_RouteChatServer
-	return nullValue
+	return pegomockmock.EqArg[RouteGuide_RouteChatServer](value)
 }
 
 func NotEqRouteguideRouteGuideRouteChatServer(value RouteGuide_RouteChatServer) RouteGuide_RouteChatServer {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue RouteGuide_RouteChatServer
-	return nullValue
+	return pegomockmock.NotEqArg[RouteGuide_RouteChatServer](value)
 }
 
 func RouteguideRouteGuideRouteChatServerThat(matcher pegomock.ArgumentMatcher) RouteGuide_RouteChatServer {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue RouteGuide_RouteChatServer
-	return nullValue
+	return pegomockmock.MatchArg[RouteGuide_RouteChatServer](matcher)
 }
 
-func AnyPtrToRouteguideRouteSummary() *RouteSummary {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(*RouteSummary))(nil)).Elem()))
-	var nullValue *RouteSummary
-	return nullValue
-}
-
-func EqPtrToRouteguideRouteSummary(value *RouteSummary) *RouteSummary {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue *RouteSummary
-	return nullValue
+func CapturePoint() *pegomockmock.Captor[Point] {
+	return pegomockmock.NewCaptor[Point]()
 }
 
-func NotEqPtrToRouteguideRouteSummary(value *RouteSummary) *RouteSummary {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue *RouteSummary
-	return nullValue
+func CaptureRectangle() *pegomockmock.Captor[Rectangle] {
+	return pegomockmock.NewCaptor[Rectangle]()
 }
 
-func PtrToRouteguideRouteSummaryThat(matcher pegomock.ArgumentMatcher) *RouteSummary {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue *RouteSummary
-	return nullValue
+func CaptureFeature() *pegomockmock.Captor[Feature] {
+	return pegomockmock.NewCaptor[Feature]()
 }
 
-func AnyPtrToRouteguidePoint() *Point {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(*Point))(nil)).Elem()))
-	var nullValue *Point
-	return nullValue
+func CaptureRouteNote() *pegomockmock.Captor[RouteNote] {
+	return pegomockmock.NewCaptor[RouteNote]()
 }
 
-func EqPtrToRouteguidePoint(value *Point) *Point {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue *Point
-	return nullValue
+func CaptureRouteSummary() *pegomockmock.Captor[RouteSummary] {
+	return pegomockmock.NewCaptor[RouteSummary]()
 }
 
-func NotEqPtrToRouteguidePoint(value *Point) *Point {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue *Point
-	return nullValue
+// PointField is the name of a field of Point.
+type PointField struct {
+	name protoreflect.Name
 }
 
-func PtrToRouteguidePointThat(matcher pegomock.ArgumentMatcher) *Point {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue *Point
-	return nullValue
+// FieldName returns the name of the field.
+func (f PointField) FieldName() protoreflect.Name {
+	return f.name
 }
 
-func AnyMetadataMD() metadata.MD {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(metadata.MD))(nil)).Elem()))
-	var nullValue metadata.MD
-	return nullValue
-}
+var (
+	PointField_Latitude  = PointField{name: "latitude"}
+	PointField_Longitude = PointField{name: "longitude"}
+)
 
-func EqMetadataMD(value metadata.MD) metadata.MD {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue metadata.MD
-	return nullValue
+func EqPointIgnoring(v *Point, fields ...PointField) *Point {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
 }
 
-func NotEqMetadataMD(value metadata.MD) metadata.MD {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue metadata.MD
-	return nullValue
+func EqPointMasked(v *Point, mask *fieldmaskpb.FieldMask) *Point {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
 }
 
-func MetadataMDThat(matcher pegomock.ArgumentMatcher) metadata.MD {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue metadata.MD
-	return nullValue
+// RectangleField is the name of a field of Rectangle.
+type RectangleField struct {
+	name protoreflect.Name
 }
 
-func AnyRouteguideRouteGuideRecordRouteServer() RouteGuide_RecordRouteServer {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(RouteGuide_RecordRouteServer))(nil)).Elem()))
-	var nullValue RouteGuide_RecordRouteServer
-	return nullValue
+// FieldName returns the name of the field.
+func (f RectangleField) FieldName() protoreflect.Name {
+	return f.name
 }
 
-func EqRouteguideRouteGuideRecordRouteServer(value RouteGuide_RecordRouteServer) RouteGuide_RecordRouteServer {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue RouteGuide_RecordRouteServer
-	return nullValue
-}
+var (
+	RectangleField_Lo = RectangleField{name: "lo"}
+	RectangleField_Hi = RectangleField{name: "hi"}
+)
 
-func NotEqRouteguideRouteGuideRecordRouteServer(value RouteGuide_RecordRouteServer) RouteGuide_RecordRouteServer {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue RouteGuide_RecordRouteServer
-	return nullValue
+func EqRectangleIgnoring(v *Rectangle, fields ...RectangleField) *Rectangle {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
 }
 
-func RouteguideRouteGuideRecordRouteServerThat(matcher pegomock.ArgumentMatcher) RouteGuide_RecordRouteServer {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue RouteGuide_RecordRouteServer
-	return nullValue
+func EqRectangleMasked(v *Rectangle, mask *fieldmaskpb.FieldMask) *Rectangle {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
 }
 
-func AnyRouteguideRouteGuideListFeaturesClient() RouteGuide_ListFeaturesClient {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(RouteGuide_ListFeaturesClient))(nil)).Elem()))
-	var nullValue RouteGuide_ListFeaturesClient
-	return nullValue
+// FeatureField is the name of a field of Feature.
+type FeatureField struct {
+	name protoreflect.Name
 }
 
-func EqRouteguideRouteGuideListFeaturesClient(value RouteGuide_ListFeaturesClient) RouteGuide_ListFeaturesClient {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue RouteGuide_ListFeaturesClient
-	return nullValue
+// FieldName returns the name of the field.
+func (f FeatureField) FieldName() protoreflect.Name {
+	return f.name
 }
 
-func NotEqRouteguideRouteGuideListFeaturesClient(value RouteGuide_ListFeaturesClient) RouteGuide_ListFeaturesClient {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue RouteGuide_ListFeaturesClient
-	return nullValue
-}
+var (
+	FeatureField_Name     = FeatureField{name: "name"}
+	FeatureField_Location = FeatureField{name: "location"}
+)
 
-func RouteguideRouteGuideListFeaturesClientThat(matcher pegomock.ArgumentMatcher) RouteGuide_ListFeaturesClient {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue RouteGuide_ListFeaturesClient
-	return nullValue
+func EqFeatureIgnoring(v *Feature, fields ...FeatureField) *Feature {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
 }
 
-func AnyRouteguideRouteGuideRecordRouteClient() RouteGuide_RecordRouteClient {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(RouteGuide_RecordRouteClient))(nil)).Elem()))
-	var nullValue RouteGuide_RecordRouteClient
-	return nullValue
+func EqFeatureMasked(v *Feature, mask *fieldmaskpb.FieldMask) *Feature {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
 }
 
-func EqRouteguideRouteGuideRecordRouteClient(value RouteGuide_RecordRouteClient) RouteGuide_RecordRouteClient {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue RouteGuide_RecordRouteClient
-	return nullValue
+// RouteNoteField is the name of a field of RouteNote.
+type RouteNoteField struct {
+	name protoreflect.Name
 }
 
-func NotEqRouteguideRouteGuideRecordRouteClient(value RouteGuide_RecordRouteClient) RouteGuide_RecordRouteClient {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue RouteGuide_RecordRouteClient
-	return nullValue
+// FieldName returns the name of the field.
+func (f RouteNoteField) FieldName() protoreflect.Name {
+	return f.name
 }
 
-func RouteguideRouteGuideRecordRouteClientThat(matcher pegomock.ArgumentMatcher) RouteGuide_RecordRouteClient {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue RouteGuide_RecordRouteClient
-	return nullValue
-}
+var (
+	RouteNoteField_Location = RouteNoteField{name: "location"}
+	RouteNoteField_Message  = RouteNoteField{name: "message"}
+)
 
-func AnyRouteguideRouteGuideListFeaturesServer() RouteGuide_ListFeaturesServer {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(RouteGuide_ListFeaturesServer))(nil)).Elem()))
-	var nullValue RouteGuide_ListFeaturesServer
-	return nullValue
+func EqRouteNoteIgnoring(v *RouteNote, fields ...RouteNoteField) *RouteNote {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
 }
 
-func EqRouteguideRouteGuideListFeaturesServer(value RouteGuide_ListFeaturesServer) RouteGuide_ListFeaturesServer {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue RouteGuide_ListFeaturesServer
-	return nullValue
+func EqRouteNoteMasked(v *RouteNote, mask *fieldmaskpb.FieldMask) *RouteNote {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
 }
 
-func NotEqRouteguideRouteGuideListFeaturesServer(value RouteGuide_ListFeaturesServer) RouteGuide_ListFeaturesServer {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue RouteGuide_ListFeaturesServer
-	return nullValue
+// RouteSummaryField is the name of a field of RouteSummary.
+type RouteSummaryField struct {
+	name protoreflect.Name
 }
 
-func RouteguideRouteGuideListFeaturesServerThat(matcher pegomock.ArgumentMatcher) RouteGuide_ListFeaturesServer {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue RouteGuide_ListFeaturesServer
-	return nullValue
+// FieldName returns the name of the field.
+func (f RouteSummaryField) FieldName() protoreflect.Name {
+	return f.name
 }
 
-func AnyRouteguideRouteGuideRouteChatClient() RouteGuide_RouteChatClient {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(RouteGuide_RouteChatClient))(nil)).Elem()))
-	var nullValue RouteGuide_RouteChatClient
-	return nullValue
-}
+var (
+	RouteSummaryField_PointCount   = RouteSummaryField{name: "point_count"}
+	RouteSummaryField_FeatureCount = RouteSummaryField{name: "feature_count"}
+	RouteSummaryField_Distance     = RouteSummaryField{name: "distance"}
+	RouteSummaryField_ElapsedTime  = RouteSummaryField{name: "elapsed_time"}
+)
 
-func EqRouteguideRouteGuideRouteChatClient(value RouteGuide_RouteChatClient) RouteGuide_RouteChatClient {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue RouteGuide_RouteChatClient
-	return nullValue
+func EqRouteSummaryIgnoring(v *RouteSummary, fields ...RouteSummaryField) *RouteSummary {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
 }
 
-func NotEqRouteguideRouteGuideRouteChatClient(value RouteGuide_RouteChatClient) RouteGuide_RouteChatClient {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue RouteGuide_RouteChatClient
-	return nullValue
+func EqRouteSummaryMasked(v *RouteSummary, mask *fieldmaskpb.FieldMask) *RouteSummary {
+	return pegomockmock.ArgThat(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
 }
 
-func RouteguideRouteGuideRouteChatClientThat(matcher pegomock.ArgumentMatcher) RouteGuide_RouteChatClient {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue RouteGuide_RouteChatClient
-	return nullValue
+func EqualPoint(v *Point) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
 }
 
-func AnyPtrToRouteguideRectangle() *Rectangle {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(*Rectangle))(nil)).Elem()))
-	var nullValue *Rectangle
-	return nullValue
+func EqualRectangle(v *Rectangle) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
 }
 
-func EqPtrToRouteguideRectangle(value *Rectangle) *Rectangle {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue *Rectangle
-	return nullValue
+func EqualFeature(v *Feature) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
 }
 
-func NotEqPtrToRouteguideRectangle(value *Rectangle) *Rectangle {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue *Rectangle
-	return nullValue
+func EqualRouteNote(v *RouteNote) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
 }
 
-func PtrToRouteguideRectangleThat(matcher pegomock.ArgumentMatcher) *Rectangle {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue *Rectangle
-	return nullValue
+func EqualRouteSummary(v *RouteSummary) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
 }
 
-func AnyPtrToRouteguideRouteNote() *RouteNote {
-	pegomock.RegisterMatcher(pegomock.NewAnyMatcher(reflect.TypeOf((*(*RouteNote))(nil)).Elem()))
-	var nullValue *RouteNote
-	return nullValue
+func HaveReceivedGetFeature(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(pegomockmock.Received, "GetFeature", args...)
 }
 
-func EqPtrToRouteguideRouteNote(value *RouteNote) *RouteNote {
-	pegomock.RegisterMatcher(&pegomock.EqMatcher{Value: value})
-	var nullValue *RouteNote
-	return nullValue
+func HaveReceivedListFeatures(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(pegomockmock.Received, "ListFeatures", args...)
 }
 
-func NotEqPtrToRouteguideRouteNote(value *RouteNote) *RouteNote {
-	pegomock.RegisterMatcher(&pegomock.NotEqMatcher{Value: value})
-	var nullValue *RouteNote
-	return nullValue
+func HaveReceivedRecordRoute(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(pegomockmock.Received, "RecordRoute", args...)
 }
 
-func PtrToRouteguideRouteNoteThat(matcher pegomock.ArgumentMatcher) *RouteNote {
-	pegomock.RegisterMatcher(matcher)
-	var nullValue *RouteNote
-	return nullValue
+func HaveReceivedRouteChat(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(pegomockmock.Received, "RouteChat", args...)
 }
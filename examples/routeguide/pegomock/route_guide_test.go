@@ -2,11 +2,24 @@
 
 import (
 	"context"
+	"errors"
+	"io"
 	"math"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/onsi/gomega"
 	"github.com/petergtz/pegomock"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/pegomockmock"
 )
 
 const (
@@ -116,6 +129,26 @@ func TestRecordRoute(t *testing.T) {
 	assert.Equal(t, routs, rs)
 }
 
+func TestRecordRouteVerification(t *testing.T) {
+	// Create a new mock server stream, which fails the test on unexpected calls.
+	stream := NewMockRouteGuide_RecordRouteServer(pegomock.WithT(t))
+	pegomock.When(stream.Recv()).ThenReturn(DresdenCenter, nil).ThenReturn((*Point)(nil), io.EOF)
+
+	// Use the server streaming handler.
+	p, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(DresdenCenter, p))
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+	assert.NoError(t, stream.SendAndClose(&RouteSummary{PointCount: 1}))
+
+	// Verify the calls and capture the arguments.
+	stream.VerifyWasCalled(pegomock.Times(2)).Recv()
+	stream.VerifyWasCalled(pegomock.Never()).SetHeader(AnyMetadataMD())
+	summary := stream.VerifyWasCalledOnce().SendAndClose(AnyPtrToRouteguideRouteSummary()).GetCapturedArguments()
+	assert.Equal(t, int32(1), summary.GetPointCount())
+}
+
 func TestRouteChat(t *testing.T) {
 	// Create a new mock client for the RouteGuide service.
 	m := NewMockRouteGuideClient()
@@ -157,3 +190,210 @@ func TestRouteChat(t *testing.T) {
 func e7(coord float64) int32 {
 	return int32(coord * math.Pow10(7))
 }
+
+func TestRouteChatScript(t *testing.T) {
+	// Create a new mock client for the RouteGuide service.
+	m := NewMockRouteGuideClient()
+
+	// Create the scripted conversation.
+	ctx := context.Background()
+	reply := &RouteNote{Location: DresdenCenter, Message: "Hello from Dresden"}
+	res := NewRouteGuide_RouteChatClientScript().
+		ExpectSend(DresdenNote).
+		ThenRecv(reply).
+		ExpectCloseSend().
+		ThenEOF()
+	defer res.AssertExpectations(t)
+
+	// Set up the expectation.
+	pegomock.When(m.RouteChat(ctx)).ThenReturn(res, nil)
+
+	// Call the client.
+	r, err := m.RouteChat(ctx)
+	assert.NoError(t, err)
+
+	// Use the client streaming handler.
+	assert.NoError(t, r.Send(DresdenNote))
+	rn, err := r.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, reply, rn)
+	assert.NoError(t, r.CloseSend())
+
+	// The conversation ends with io.EOF.
+	_, err = r.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestRecordRoutePipe(t *testing.T) {
+	// Create the connected client and server streams.
+	client, server := NewRouteGuide_RecordRoutePipe(context.Background())
+	routs := &RouteSummary{PointCount: 1}
+
+	// Run a server handler counting the points.
+	go func() {
+		server.Finish(func(stream RouteGuide_RecordRouteServer) error {
+			var count int32
+			for {
+				_, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					return stream.SendAndClose(&RouteSummary{PointCount: count})
+				}
+				if err != nil {
+					return err
+				}
+				count++
+			}
+		}(server))
+	}()
+
+	// Use the client streaming handler.
+	assert.NoError(t, client.Send(DresdenCenter))
+	rs, err := client.CloseAndRecv()
+
+	// Check that the response is as expected.
+	assert.NoError(t, err)
+	assert.Equal(t, routs.GetPointCount(), rs.GetPointCount())
+}
+
+func TestGetFeatureServerInterceptors(t *testing.T) {
+	// Create a new mock server for the RouteGuide service.
+	m := NewMockRouteGuideServer(pegomock.WithT(t))
+
+	// Wrap the mock with a server interceptor, which validates the request.
+	var methods []string
+	validate := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		methods = append(methods, info.FullMethod)
+		if req.(*Point).GetLatitude() == 0 {
+			return nil, status.Error(codes.InvalidArgument, "latitude is required")
+		}
+		return handler(ctx, req)
+	}
+	srv := NewRouteGuideServerWithInterceptors(m, grpcmock.ServerInterceptors{Unary: []grpc.UnaryServerInterceptor{validate}})
+
+	// Set up the expectation.
+	ctx := context.Background()
+	res := &Feature{Name: "Dresden", Location: DresdenCenter}
+	pegomock.When(m.GetFeature(ctx, DresdenCenter)).ThenReturn(res, nil)
+
+	// Call the server with a valid and an invalid request.
+	r, err := srv.GetFeature(ctx, DresdenCenter)
+	assert.NoError(t, err)
+	assert.Equal(t, res, r)
+	_, err = srv.GetFeature(ctx, &Point{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Check that the interceptor ran for both calls, but only the valid one reached the mock.
+	assert.Equal(t, []string{"/routeguide.RouteGuide/GetFeature", "/routeguide.RouteGuide/GetFeature"}, methods)
+	m.VerifyWasCalledOnce().GetFeature(ctx, DresdenCenter)
+	m.VerifyWasCalled(pegomock.Never()).GetFeature(ctx, &Point{})
+}
+
+func TestGetFeatureGomega(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// Create a new mock client for the RouteGuide service.
+	m := NewMockRouteGuideClient(pegomock.WithT(t))
+
+	// Create the request and response.
+	ctx := context.Background()
+	req := DresdenCenter
+	res := &Feature{Name: "Dresden", Location: req}
+
+	// Set up the expectation.
+	pegomock.When(m.GetFeature(ctx, req)).ThenReturn(res, nil)
+
+	// Call the client.
+	r, err := m.GetFeature(ctx, req)
+
+	// Check the response and the received request with Gomega.
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(r).To(EqualFeature(&Feature{Name: "Dresden", Location: DresdenCenter}))
+	g.Expect(m).To(HaveReceivedGetFeature(ctx, &Point{Latitude: e7(DresdenLatitude), Longitude: e7(DresdenLongitude)}))
+	g.Expect(m).NotTo(HaveReceivedGetFeature(ctx, &Point{}))
+	g.Expect(m).NotTo(HaveReceivedListFeatures(ctx, GermanyBoundingBox))
+}
+
+func TestEqualFeatureDiff(t *testing.T) {
+	matcher := EqualFeature(&Feature{Name: "Dresden", Location: DresdenCenter})
+	actual := &Feature{Name: "Leipzig", Location: DresdenCenter}
+
+	ok, err := matcher.Match(actual)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	// The failure message contains a diff of both messages.
+	msg := matcher.FailureMessage(actual)
+	assert.Regexp(t, `\n-\s+name:\s+"Dresden"\n\+\s+name:\s+"Leipzig"\n`, msg)
+}
+
+// runFunc runs the tests of RunWithCoverage as a function.
+type runFunc func() int
+
+func (f runFunc) Run() int { return f() }
+
+func TestCoverage(t *testing.T) {
+	// Create a new mock client with the coverage enabled, stub two methods and call one of them.
+	dir := t.TempDir()
+	anyContext := func() context.Context { return pegomockmock.ArgThat(func(context.Context) bool { return true }) }
+	code := grpcmock.RunWithCoverage(runFunc(func() int {
+		m := NewMockRouteGuideClient(pegomock.WithT(t))
+		pegomock.When(m.GetFeature(context.Background(), DresdenCenter)).ThenReturn(&Feature{Name: "Dresden"}, nil)
+		pegomock.When(m.GetFeature(anyContext(), EqPointIgnoring(&Point{Latitude: 1}, PointField_Longitude))).ThenReturn(&Feature{}, nil)
+		pegomock.When(m.ListFeatures(context.Background(), GermanyBoundingBox)).ThenReturn(nil, errors.New("not found"))
+		_, _ = m.GetFeature(context.Background(), DresdenCenter)
+		m.VerifyWasCalledOnce().GetFeature(anyContext(), pegomockmock.AnyArg[*Point]())
+		return 0
+	}), dir)
+	assert.Zero(t, code)
+
+	// Check that only the call is counted as called, the stubs as stubbed and
+	// the stubs without a matching call as unmatched.
+	report := grpcmock.CurrentCoverage()
+	assert.Equal(t, []grpcmock.ServiceCoverage{{
+		Service: "routeguide.RouteGuide",
+		Methods: []grpcmock.MethodCoverage{
+			{Method: "GetFeature", Stubbed: 2, Called: 1, Status: grpcmock.MethodCalled, Unmatched: []string{
+				"GetFeature(ArgThat(context.Context), ArgThat(*routeguide.Point))",
+			}},
+			{Method: "ListFeatures", Stubbed: 1, Called: 0, Status: grpcmock.MethodStubNotCalled, Unmatched: []string{
+				"ListFeatures(Eq(context.Background), Eq(*routeguide.Rectangle{" + prototext.MarshalOptions{}.Format(GermanyBoundingBox) + "}))",
+			}},
+			{Method: "RecordRoute", Status: grpcmock.MethodUnused},
+			{Method: "RouteChat", Status: grpcmock.MethodUnused},
+		},
+	}}, report.Services)
+
+	// Check that the report is written as text.
+	data, err := os.ReadFile(filepath.Join(dir, grpcmock.CoverageTextFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), "routeguide.RouteGuide")
+	assert.Contains(t, string(data), "UNMATCHED STUBS\n  GetFeature(ArgThat(context.Context), ArgThat(*routeguide.Point))\n")
+}
+
+func TestCaptor(t *testing.T) {
+	// Create a new mock server and capture the points.
+	m := NewMockRouteGuideServer(pegomock.WithT(t))
+	c := CapturePoint()
+	anyContext := pegomockmock.ArgThat(func(context.Context) bool { return true })
+	pegomock.When(m.GetFeature(anyContext, c.Capture())).ThenReturn(&Feature{}, nil)
+
+	// Call the server with two points.
+	_, _ = m.GetFeature(context.Background(), DresdenCenter)
+	_, _ = m.GetFeature(context.Background(), &Point{Latitude: 1})
+
+	// Check the captured points.
+	assert.Len(t, c.All(), 2)
+	assert.True(t, proto.Equal(DresdenCenter, c.All()[0]))
+	assert.Equal(t, int32(1), c.Last().GetLatitude())
+}
+
+func TestFieldMatchers(t *testing.T) {
+	// Create a stream mock, which ignores the message of the notes.
+	m := NewMockRouteGuide_RouteChatServer(pegomock.WithT(t))
+	want := &RouteNote{Location: DresdenCenter, Message: "Hello"}
+	pegomock.When(m.Send(EqRouteNoteIgnoring(want, RouteNoteField_Message))).ThenReturn(io.EOF)
+
+	// Check that the note matches the expectation.
+	assert.ErrorIs(t, m.Send(&RouteNote{Location: DresdenCenter, Message: "Hi"}), io.EOF)
+	assert.NoError(t, m.Send(&RouteNote{Message: "Hello"}))
+}
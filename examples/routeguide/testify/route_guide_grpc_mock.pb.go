@@ -9,31 +9,57 @@
 
 import (
 	context "context"
+	grpcmock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+	gomegamock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/gomegamock"
+	testifymock "github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/testifymock"
+	types "github.com/onsi/gomega/types"
 	mock "github.com/stretchr/testify/mock"
+	suite "github.com/stretchr/testify/suite"
 	grpc "google.golang.org/grpc"
-	metadata "google.golang.org/grpc/metadata"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func AnyPoint() mock.AnythingOfTypeArgument {
 	return mock.AnythingOfType("*routeguide.Point")
 }
 
+func EqPoint(v *Point) interface{} {
+	return testifymock.EqMessage(v)
+}
+
 func AnyRectangle() mock.AnythingOfTypeArgument {
 	return mock.AnythingOfType("*routeguide.Rectangle")
 }
 
+func EqRectangle(v *Rectangle) interface{} {
+	return testifymock.EqMessage(v)
+}
+
 func AnyFeature() mock.AnythingOfTypeArgument {
 	return mock.AnythingOfType("*routeguide.Feature")
 }
 
+func EqFeature(v *Feature) interface{} {
+	return testifymock.EqMessage(v)
+}
+
 func AnyRouteNote() mock.AnythingOfTypeArgument {
 	return mock.AnythingOfType("*routeguide.RouteNote")
 }
 
+func EqRouteNote(v *RouteNote) interface{} {
+	return testifymock.EqMessage(v)
+}
+
 func AnyRouteSummary() mock.AnythingOfTypeArgument {
 	return mock.AnythingOfType("*routeguide.RouteSummary")
 }
 
+func EqRouteSummary(v *RouteSummary) interface{} {
+	return testifymock.EqMessage(v)
+}
+
 func AnyRouteGuide_ListFeaturesClient() mock.AnythingOfTypeArgument {
 	return mock.AnythingOfType("*routeguide.RouteGuide_ListFeaturesClient")
 }
@@ -60,10 +86,18 @@ func AnyRouteGuide_RouteChatServer() mock.AnythingOfTypeArgument {
 
 type MockRouteGuideClient struct {
 	mock.Mock
+	testifymock.Unstubbed
+	expectations testifymock.Expectations
+}
+
+func (m *MockRouteGuideClient) On(method string, args ...interface{}) *mock.Call {
+	return testifymock.On(&m.Mock, &m.expectations, method, args...)
 }
 
 func NewMockRouteGuideClient() *MockRouteGuideClient {
-	return &MockRouteGuideClient{}
+	m := &MockRouteGuideClient{}
+	testifymock.TrackCoverage("routeguide.RouteGuide", []string{"GetFeature", "ListFeatures", "RecordRoute", "RouteChat"}, &m.expectations)
+	return m
 }
 
 func (c *MockRouteGuideClient) GetFeature(ctx context.Context, in *Point, opts ...grpc.CallOption) (*Feature, error) {
@@ -71,12 +105,12 @@ func (c *MockRouteGuideClient) GetFeature(ctx context.Context, in *Point, opts .
 	for _, opts1 := range opts {
 		opts0 = append(opts0, opts1)
 	}
-	args := c.Called(opts0...)
+	args := testifymock.LenientCalled(&c.Mock, &c.expectations, &c.Unstubbed, testifymock.Unimplemented("/routeguide.RouteGuide/GetFeature", (*Feature)(nil)), "GetFeature", opts0...)
 	return args.Get(0).(*Feature), args.Error(1)
 }
 
 func (c *MockRouteGuideClient) OnGetFeature(ctx interface{}, in interface{}, opts ...interface{}) *mock.Call {
-	return c.On("GetFeature", append([]interface{}{ctx, in}, opts...)...)
+	return testifymock.On(&c.Mock, &c.expectations, "GetFeature", append([]interface{}{ctx, in}, opts...)...)
 }
 
 func (c *MockRouteGuideClient) ListFeatures(ctx context.Context, in *Rectangle, opts ...grpc.CallOption) (RouteGuide_ListFeaturesClient, error) {
@@ -84,395 +118,529 @@ func (c *MockRouteGuideClient) ListFeatures(ctx context.Context, in *Rectangle,
 	for _, opts1 := range opts {
 		opts0 = append(opts0, opts1)
 	}
-	args := c.Called(opts0...)
+	args := testifymock.LenientCalled(&c.Mock, &c.expectations, &c.Unstubbed, testifymock.EndOfStream[Rectangle, Feature](ctx, "/routeguide.RouteGuide/ListFeatures"), "ListFeatures", opts0...)
 	return args.Get(0).(RouteGuide_ListFeaturesClient), args.Error(1)
 }
 
 func (c *MockRouteGuideClient) OnListFeatures(ctx interface{}, in interface{}, opts ...interface{}) *mock.Call {
-	return c.On("ListFeatures", append([]interface{}{ctx, in}, opts...)...)
+	return testifymock.On(&c.Mock, &c.expectations, "ListFeatures", append([]interface{}{ctx, in}, opts...)...)
 }
 
 type MockRouteGuide_ListFeaturesClient struct {
-	mock.Mock
+	testifymock.ServerStreamingClient[Feature]
 }
 
 func NewMockRouteGuide_ListFeaturesClient() *MockRouteGuide_ListFeaturesClient {
-	return &MockRouteGuide_ListFeaturesClient{}
+	m := &MockRouteGuide_ListFeaturesClient{}
+	m.SetLenient(true)
+	return m
 }
 
-func (x *MockRouteGuide_ListFeaturesClient) Header() (metadata.MD, error) {
-	args := x.Called()
-	return args.Get(0).(metadata.MD), args.Error(1)
+func (c *MockRouteGuideClient) RecordRoute(ctx context.Context, opts ...grpc.CallOption) (RouteGuide_RecordRouteClient, error) {
+	opts0 := []interface{}{ctx}
+	for _, opts1 := range opts {
+		opts0 = append(opts0, opts1)
+	}
+	args := testifymock.LenientCalled(&c.Mock, &c.expectations, &c.Unstubbed, testifymock.EndOfStream[Point, RouteSummary](ctx, "/routeguide.RouteGuide/RecordRoute"), "RecordRoute", opts0...)
+	return args.Get(0).(RouteGuide_RecordRouteClient), args.Error(1)
 }
 
-func (x *MockRouteGuide_ListFeaturesClient) Trailer() metadata.MD {
-	args := x.Called()
-	return args.Get(0).(metadata.MD)
+func (c *MockRouteGuideClient) OnRecordRoute(ctx interface{}, opts ...interface{}) *mock.Call {
+	return testifymock.On(&c.Mock, &c.expectations, "RecordRoute", append([]interface{}{ctx}, opts...)...)
 }
 
-func (x *MockRouteGuide_ListFeaturesClient) CloseSend() error {
-	args := x.Called()
-	return args.Error(0)
+type MockRouteGuide_RecordRouteClient struct {
+	testifymock.ClientStreamingClient[Point, RouteSummary]
 }
 
-func (x *MockRouteGuide_ListFeaturesClient) Context() context.Context {
-	args := x.Called()
-	return args.Get(0).(context.Context)
+func NewMockRouteGuide_RecordRouteClient() *MockRouteGuide_RecordRouteClient {
+	m := &MockRouteGuide_RecordRouteClient{}
+	m.SetLenient(true)
+	return m
 }
 
-func (x *MockRouteGuide_ListFeaturesClient) SendMsg(m interface{}) error {
-	args := x.Called(m)
-	return args.Error(0)
+func (c *MockRouteGuideClient) RouteChat(ctx context.Context, opts ...grpc.CallOption) (RouteGuide_RouteChatClient, error) {
+	opts0 := []interface{}{ctx}
+	for _, opts1 := range opts {
+		opts0 = append(opts0, opts1)
+	}
+	args := testifymock.LenientCalled(&c.Mock, &c.expectations, &c.Unstubbed, testifymock.EndOfStream[RouteNote, RouteNote](ctx, "/routeguide.RouteGuide/RouteChat"), "RouteChat", opts0...)
+	return args.Get(0).(RouteGuide_RouteChatClient), args.Error(1)
 }
 
-func (x *MockRouteGuide_ListFeaturesClient) RecvMsg(m interface{}) error {
-	args := x.Called(m)
-	return args.Error(0)
+func (c *MockRouteGuideClient) OnRouteChat(ctx interface{}, opts ...interface{}) *mock.Call {
+	return testifymock.On(&c.Mock, &c.expectations, "RouteChat", append([]interface{}{ctx}, opts...)...)
 }
 
-func (x *MockRouteGuide_ListFeaturesClient) Recv() (*Feature, error) {
-	args := x.Called()
-	return args.Get(0).(*Feature), args.Error(1)
+type MockRouteGuide_RouteChatClient struct {
+	testifymock.BidiStreamingClient[RouteNote, RouteNote]
 }
 
-func (x *MockRouteGuide_ListFeaturesClient) OnRecv() *mock.Call {
-	return x.On("Recv")
+func NewMockRouteGuide_RouteChatClient() *MockRouteGuide_RouteChatClient {
+	m := &MockRouteGuide_RouteChatClient{}
+	m.SetLenient(true)
+	return m
 }
 
-func (c *MockRouteGuideClient) RecordRoute(ctx context.Context, opts ...grpc.CallOption) (RouteGuide_RecordRouteClient, error) {
-	opts0 := []interface{}{ctx}
-	for _, opts1 := range opts {
-		opts0 = append(opts0, opts1)
-	}
-	args := c.Called(opts0...)
-	return args.Get(0).(RouteGuide_RecordRouteClient), args.Error(1)
+type MockRouteGuideServer struct {
+	mock.Mock
+	UnimplementedRouteGuideServer
+	testifymock.Unstubbed
+	expectations testifymock.Expectations
 }
 
-func (c *MockRouteGuideClient) OnRecordRoute(ctx interface{}, opts ...interface{}) *mock.Call {
-	return c.On("RecordRoute", append([]interface{}{ctx}, opts...)...)
+func (m *MockRouteGuideServer) On(method string, args ...interface{}) *mock.Call {
+	return testifymock.On(&m.Mock, &m.expectations, method, args...)
 }
 
-type MockRouteGuide_RecordRouteClient struct {
-	mock.Mock
+func NewMockRouteGuideServer() *MockRouteGuideServer {
+	m := &MockRouteGuideServer{}
+	testifymock.TrackCoverage("routeguide.RouteGuide", []string{"GetFeature", "ListFeatures", "RecordRoute", "RouteChat"}, &m.expectations)
+	return m
 }
 
-func NewMockRouteGuide_RecordRouteClient() *MockRouteGuide_RecordRouteClient {
-	return &MockRouteGuide_RecordRouteClient{}
+func (s *MockRouteGuideServer) GetFeature(ctx context.Context, in *Point) (*Feature, error) {
+	args := testifymock.LenientCalled(&s.Mock, &s.expectations, &s.Unstubbed, testifymock.Unimplemented("/routeguide.RouteGuide/GetFeature", (*Feature)(nil)), "GetFeature", ctx, in)
+	return args.Get(0).(*Feature), args.Error(1)
 }
 
-func (x *MockRouteGuide_RecordRouteClient) Header() (metadata.MD, error) {
-	args := x.Called()
-	return args.Get(0).(metadata.MD), args.Error(1)
+func (s *MockRouteGuideServer) OnGetFeature(ctx interface{}, in interface{}) *mock.Call {
+	return testifymock.On(&s.Mock, &s.expectations, "GetFeature", ctx, in)
 }
 
-func (x *MockRouteGuide_RecordRouteClient) Trailer() metadata.MD {
-	args := x.Called()
-	return args.Get(0).(metadata.MD)
+func (s *MockRouteGuideServer) ListFeatures(in *Rectangle, out RouteGuide_ListFeaturesServer) error {
+	args := testifymock.LenientCalled(&s.Mock, &s.expectations, &s.Unstubbed, testifymock.Returns(nil), "ListFeatures", in, out)
+	return args.Error(0)
 }
 
-func (x *MockRouteGuide_RecordRouteClient) CloseSend() error {
-	args := x.Called()
-	return args.Error(0)
+func (s *MockRouteGuideServer) OnListFeatures(in interface{}, out interface{}) *mock.Call {
+	return testifymock.On(&s.Mock, &s.expectations, "ListFeatures", in, out)
 }
 
-func (x *MockRouteGuide_RecordRouteClient) Context() context.Context {
-	args := x.Called()
-	return args.Get(0).(context.Context)
+type MockRouteGuide_ListFeaturesServer struct {
+	testifymock.ServerStreamingServer[Feature]
 }
 
-func (x *MockRouteGuide_RecordRouteClient) SendMsg(m interface{}) error {
-	args := x.Called(m)
-	return args.Error(0)
+func NewMockRouteGuide_ListFeaturesServer() *MockRouteGuide_ListFeaturesServer {
+	m := &MockRouteGuide_ListFeaturesServer{}
+	m.SetLenient(true)
+	return m
 }
 
-func (x *MockRouteGuide_RecordRouteClient) RecvMsg(m interface{}) error {
-	args := x.Called(m)
+func (s *MockRouteGuideServer) RecordRoute(out RouteGuide_RecordRouteServer) error {
+	args := testifymock.LenientCalled(&s.Mock, &s.expectations, &s.Unstubbed, testifymock.Returns(nil), "RecordRoute", out)
 	return args.Error(0)
 }
 
-func (x *MockRouteGuide_RecordRouteClient) Send(m *Point) error {
-	args := x.Called(m)
+func (s *MockRouteGuideServer) OnRecordRoute(out interface{}) *mock.Call {
+	return testifymock.On(&s.Mock, &s.expectations, "RecordRoute", out)
+}
+
+type MockRouteGuide_RecordRouteServer struct {
+	testifymock.ClientStreamingServer[Point, RouteSummary]
+}
+
+func NewMockRouteGuide_RecordRouteServer() *MockRouteGuide_RecordRouteServer {
+	m := &MockRouteGuide_RecordRouteServer{}
+	m.SetLenient(true)
+	return m
+}
+
+func (s *MockRouteGuideServer) RouteChat(out RouteGuide_RouteChatServer) error {
+	args := testifymock.LenientCalled(&s.Mock, &s.expectations, &s.Unstubbed, testifymock.Returns(nil), "RouteChat", out)
 	return args.Error(0)
 }
 
-func (x *MockRouteGuide_RecordRouteClient) OnSend(m interface{}) *mock.Call {
-	return x.On("Send", m)
+func (s *MockRouteGuideServer) OnRouteChat(out interface{}) *mock.Call {
+	return testifymock.On(&s.Mock, &s.expectations, "RouteChat", out)
+}
+
+type MockRouteGuide_RouteChatServer struct {
+	testifymock.BidiStreamingServer[RouteNote, RouteNote]
 }
 
-func (x *MockRouteGuide_RecordRouteClient) CloseAndRecv() (*RouteSummary, error) {
-	args := x.Called()
-	return args.Get(0).(*RouteSummary), args.Error(1)
+func NewMockRouteGuide_RouteChatServer() *MockRouteGuide_RouteChatServer {
+	m := &MockRouteGuide_RouteChatServer{}
+	m.SetLenient(true)
+	return m
 }
 
-func (x *MockRouteGuide_RecordRouteClient) OnCloseAndRecv() *mock.Call {
-	return x.On("CloseAndRecv")
+func NewRouteGuide_RouteChatClientScript() *grpcmock.Script[RouteNote, RouteNote] {
+	return grpcmock.NewScript[RouteNote, RouteNote]("/routeguide.RouteGuide/RouteChat")
 }
 
-func (c *MockRouteGuideClient) RouteChat(ctx context.Context, opts ...grpc.CallOption) (RouteGuide_RouteChatClient, error) {
-	opts0 := []interface{}{ctx}
-	for _, opts1 := range opts {
-		opts0 = append(opts0, opts1)
-	}
-	args := c.Called(opts0...)
-	return args.Get(0).(RouteGuide_RouteChatClient), args.Error(1)
+func NewRouteGuide_ListFeaturesPipe(ctx context.Context) (*grpcmock.ClientStream[Rectangle, Feature], *grpcmock.ServerStream[Rectangle, Feature]) {
+	return grpcmock.NewPipe[Rectangle, Feature](ctx, "/routeguide.RouteGuide/ListFeatures")
 }
 
-func (c *MockRouteGuideClient) OnRouteChat(ctx interface{}, opts ...interface{}) *mock.Call {
-	return c.On("RouteChat", append([]interface{}{ctx}, opts...)...)
+func NewRouteGuide_RecordRoutePipe(ctx context.Context) (*grpcmock.ClientStream[Point, RouteSummary], *grpcmock.ServerStream[Point, RouteSummary]) {
+	return grpcmock.NewPipe[Point, RouteSummary](ctx, "/routeguide.RouteGuide/RecordRoute")
 }
 
-type MockRouteGuide_RouteChatClient struct {
-	mock.Mock
+func NewRouteGuide_RouteChatPipe(ctx context.Context) (*grpcmock.ClientStream[RouteNote, RouteNote], *grpcmock.ServerStream[RouteNote, RouteNote]) {
+	return grpcmock.NewPipe[RouteNote, RouteNote](ctx, "/routeguide.RouteGuide/RouteChat")
 }
 
-func NewMockRouteGuide_RouteChatClient() *MockRouteGuide_RouteChatClient {
-	return &MockRouteGuide_RouteChatClient{}
+type interceptedRouteGuideClient struct {
+	client       RouteGuideClient
+	interceptors grpcmock.ClientInterceptors
 }
 
-func (x *MockRouteGuide_RouteChatClient) Header() (metadata.MD, error) {
-	args := x.Called()
-	return args.Get(0).(metadata.MD), args.Error(1)
+func NewRouteGuideClientWithInterceptors(client RouteGuideClient, interceptors grpcmock.ClientInterceptors) RouteGuideClient {
+	return &interceptedRouteGuideClient{client: client, interceptors: interceptors}
 }
 
-func (x *MockRouteGuide_RouteChatClient) Trailer() metadata.MD {
-	args := x.Called()
-	return args.Get(0).(metadata.MD)
+func (c *interceptedRouteGuideClient) GetFeature(ctx context.Context, in *Point, opts ...grpc.CallOption) (*Feature, error) {
+	return grpcmock.InterceptUnaryClient(ctx, c.interceptors.Unary, "/routeguide.RouteGuide/GetFeature", in, opts, c.client.GetFeature)
 }
 
-func (x *MockRouteGuide_RouteChatClient) CloseSend() error {
-	args := x.Called()
-	return args.Error(0)
+func (c *interceptedRouteGuideClient) ListFeatures(ctx context.Context, in *Rectangle, opts ...grpc.CallOption) (RouteGuide_ListFeaturesClient, error) {
+	return grpcmock.InterceptStreamClient(ctx, c.interceptors.Stream, &grpc.StreamDesc{StreamName: "ListFeatures", ClientStreams: false, ServerStreams: true}, "/routeguide.RouteGuide/ListFeatures", opts,
+		func(ctx context.Context, opts ...grpc.CallOption) (RouteGuide_ListFeaturesClient, error) {
+			return c.client.ListFeatures(ctx, in, opts...)
+		},
+		func(s grpc.ClientStream) RouteGuide_ListFeaturesClient {
+			return &grpcmock.InterceptedClientStream[Rectangle, Feature]{ClientStream: s}
+		})
 }
 
-func (x *MockRouteGuide_RouteChatClient) Context() context.Context {
-	args := x.Called()
-	return args.Get(0).(context.Context)
+func (c *interceptedRouteGuideClient) RecordRoute(ctx context.Context, opts ...grpc.CallOption) (RouteGuide_RecordRouteClient, error) {
+	return grpcmock.InterceptStreamClient(ctx, c.interceptors.Stream, &grpc.StreamDesc{StreamName: "RecordRoute", ClientStreams: true, ServerStreams: false}, "/routeguide.RouteGuide/RecordRoute", opts,
+		c.client.RecordRoute,
+		func(s grpc.ClientStream) RouteGuide_RecordRouteClient {
+			return &grpcmock.InterceptedClientStream[Point, RouteSummary]{ClientStream: s}
+		})
 }
 
-func (x *MockRouteGuide_RouteChatClient) SendMsg(m interface{}) error {
-	args := x.Called(m)
-	return args.Error(0)
+func (c *interceptedRouteGuideClient) RouteChat(ctx context.Context, opts ...grpc.CallOption) (RouteGuide_RouteChatClient, error) {
+	return grpcmock.InterceptStreamClient(ctx, c.interceptors.Stream, &grpc.StreamDesc{StreamName: "RouteChat", ClientStreams: true, ServerStreams: true}, "/routeguide.RouteGuide/RouteChat", opts,
+		c.client.RouteChat,
+		func(s grpc.ClientStream) RouteGuide_RouteChatClient {
+			return &grpcmock.InterceptedClientStream[RouteNote, RouteNote]{ClientStream: s}
+		})
 }
 
-func (x *MockRouteGuide_RouteChatClient) RecvMsg(m interface{}) error {
-	args := x.Called(m)
-	return args.Error(0)
+type interceptedRouteGuideServer struct {
+	UnimplementedRouteGuideServer
+	server       RouteGuideServer
+	interceptors grpcmock.ServerInterceptors
 }
 
-func (x *MockRouteGuide_RouteChatClient) Send(m *RouteNote) error {
-	args := x.Called(m)
-	return args.Error(0)
+func NewRouteGuideServerWithInterceptors(server RouteGuideServer, interceptors grpcmock.ServerInterceptors) RouteGuideServer {
+	return &interceptedRouteGuideServer{server: server, interceptors: interceptors}
 }
 
-func (x *MockRouteGuide_RouteChatClient) OnSend(m interface{}) *mock.Call {
-	return x.On("Send", m)
+func (s *interceptedRouteGuideServer) GetFeature(ctx context.Context, in *Point) (*Feature, error) {
+	return grpcmock.InterceptUnaryServer(ctx, s.interceptors.Unary, s.server, "/routeguide.RouteGuide/GetFeature", in, s.server.GetFeature)
 }
 
-func (x *MockRouteGuide_RouteChatClient) Recv() (*RouteNote, error) {
-	args := x.Called()
-	return args.Get(0).(*RouteNote), args.Error(1)
+func (s *interceptedRouteGuideServer) ListFeatures(in *Rectangle, stream RouteGuide_ListFeaturesServer) error {
+	return grpcmock.InterceptStreamServer(s.interceptors.Stream, s.server, &grpc.StreamDesc{StreamName: "ListFeatures", ClientStreams: false, ServerStreams: true}, "/routeguide.RouteGuide/ListFeatures", stream,
+		func(stream RouteGuide_ListFeaturesServer) error {
+			return s.server.ListFeatures(in, stream)
+		},
+		func(ss grpc.ServerStream) RouteGuide_ListFeaturesServer {
+			return &grpcmock.InterceptedServerStream[Rectangle, Feature]{ServerStream: ss}
+		})
 }
 
-func (x *MockRouteGuide_RouteChatClient) OnRecv() *mock.Call {
-	return x.On("Recv")
+func (s *interceptedRouteGuideServer) RecordRoute(stream RouteGuide_RecordRouteServer) error {
+	return grpcmock.InterceptStreamServer(s.interceptors.Stream, s.server, &grpc.StreamDesc{StreamName: "RecordRoute", ClientStreams: true, ServerStreams: false}, "/routeguide.RouteGuide/RecordRoute", stream,
+		s.server.RecordRoute,
+		func(ss grpc.ServerStream) RouteGuide_RecordRouteServer {
+			return &grpcmock.InterceptedServerStream[Point, RouteSummary]{ServerStream: ss}
+		})
 }
 
-type MockRouteGuideServer struct {
-	mock.Mock
+func (s *interceptedRouteGuideServer) RouteChat(stream RouteGuide_RouteChatServer) error {
+	return grpcmock.InterceptStreamServer(s.interceptors.Stream, s.server, &grpc.StreamDesc{StreamName: "RouteChat", ClientStreams: true, ServerStreams: true}, "/routeguide.RouteGuide/RouteChat", stream,
+		s.server.RouteChat,
+		func(ss grpc.ServerStream) RouteGuide_RouteChatServer {
+			return &grpcmock.InterceptedServerStream[RouteNote, RouteNote]{ServerStream: ss}
+		})
 }
 
-func NewMockRouteGuideServer() *MockRouteGuideServer {
-	return &MockRouteGuideServer{}
+var (
+	_ RouteGuideClient              = (*MockRouteGuideClient)(nil)
+	_ RouteGuideServer              = (*MockRouteGuideServer)(nil)
+	_ RouteGuide_ListFeaturesClient = (*MockRouteGuide_ListFeaturesClient)(nil)
+	_ RouteGuide_ListFeaturesServer = (*MockRouteGuide_ListFeaturesServer)(nil)
+	_ RouteGuide_ListFeaturesClient = (*grpcmock.ClientStream[Rectangle, Feature])(nil)
+	_ RouteGuide_ListFeaturesServer = (*grpcmock.ServerStream[Rectangle, Feature])(nil)
+	_ RouteGuide_ListFeaturesClient = (*grpcmock.InterceptedClientStream[Rectangle, Feature])(nil)
+	_ RouteGuide_ListFeaturesServer = (*grpcmock.InterceptedServerStream[Rectangle, Feature])(nil)
+	_ RouteGuide_RecordRouteClient  = (*MockRouteGuide_RecordRouteClient)(nil)
+	_ RouteGuide_RecordRouteServer  = (*MockRouteGuide_RecordRouteServer)(nil)
+	_ RouteGuide_RecordRouteClient  = (*grpcmock.ClientStream[Point, RouteSummary])(nil)
+	_ RouteGuide_RecordRouteServer  = (*grpcmock.ServerStream[Point, RouteSummary])(nil)
+	_ RouteGuide_RecordRouteClient  = (*grpcmock.InterceptedClientStream[Point, RouteSummary])(nil)
+	_ RouteGuide_RecordRouteServer  = (*grpcmock.InterceptedServerStream[Point, RouteSummary])(nil)
+	_ RouteGuide_RouteChatClient    = (*MockRouteGuide_RouteChatClient)(nil)
+	_ RouteGuide_RouteChatServer    = (*MockRouteGuide_RouteChatServer)(nil)
+	_ RouteGuide_RouteChatClient    = (*grpcmock.ClientStream[RouteNote, RouteNote])(nil)
+	_ RouteGuide_RouteChatServer    = (*grpcmock.ServerStream[RouteNote, RouteNote])(nil)
+	_ RouteGuide_RouteChatClient    = (*grpcmock.InterceptedClientStream[RouteNote, RouteNote])(nil)
+	_ RouteGuide_RouteChatServer    = (*grpcmock.InterceptedServerStream[RouteNote, RouteNote])(nil)
+	_ RouteGuide_RouteChatClient    = (*grpcmock.Script[RouteNote, RouteNote])(nil)
+)
+
+// RouteGuideMockSuite is a testify suite providing fresh mocks of the RouteGuide service for each test.
+// The client mock returns the stream mocks of the suite, when the streaming methods are stubbed.
+type RouteGuideMockSuite struct {
+	suite.Suite
+
+	Client             *MockRouteGuideClient
+	Server             *MockRouteGuideServer
+	ListFeaturesClient *MockRouteGuide_ListFeaturesClient
+	ListFeaturesServer *MockRouteGuide_ListFeaturesServer
+	RecordRouteClient  *MockRouteGuide_RecordRouteClient
+	RecordRouteServer  *MockRouteGuide_RecordRouteServer
+	RouteChatClient    *MockRouteGuide_RouteChatClient
+	RouteChatServer    *MockRouteGuide_RouteChatServer
 }
 
-func (s *MockRouteGuideServer) GetFeature(ctx context.Context, in *Point) (*Feature, error) {
-	args := s.Called(ctx, in)
-	return args.Get(0).(*Feature), args.Error(1)
+func (s *RouteGuideMockSuite) SetupTest() {
+	s.Client = NewMockRouteGuideClient()
+	testifymock.Test(&s.Client.Mock, s.T())
+	s.Server = NewMockRouteGuideServer()
+	testifymock.Test(&s.Server.Mock, s.T())
+	s.ListFeaturesClient = NewMockRouteGuide_ListFeaturesClient()
+	testifymock.Test(&s.ListFeaturesClient.Mock, s.T())
+	s.ListFeaturesServer = NewMockRouteGuide_ListFeaturesServer()
+	testifymock.Test(&s.ListFeaturesServer.Mock, s.T())
+	s.RecordRouteClient = NewMockRouteGuide_RecordRouteClient()
+	testifymock.Test(&s.RecordRouteClient.Mock, s.T())
+	s.RecordRouteServer = NewMockRouteGuide_RecordRouteServer()
+	testifymock.Test(&s.RecordRouteServer.Mock, s.T())
+	s.RouteChatClient = NewMockRouteGuide_RouteChatClient()
+	testifymock.Test(&s.RouteChatClient.Mock, s.T())
+	s.RouteChatServer = NewMockRouteGuide_RouteChatServer()
+	testifymock.Test(&s.RouteChatServer.Mock, s.T())
 }
 
-func (s *MockRouteGuideServer) OnGetFeature(ctx interface{}, in interface{}) *mock.Call {
-	return s.On("GetFeature", ctx, in)
+func (s *RouteGuideMockSuite) TearDownTest() {
+	s.Client.AssertExpectations(s.T())
+	s.Server.AssertExpectations(s.T())
+	s.ListFeaturesClient.AssertExpectations(s.T())
+	s.ListFeaturesServer.AssertExpectations(s.T())
+	s.RecordRouteClient.AssertExpectations(s.T())
+	s.RecordRouteServer.AssertExpectations(s.T())
+	s.RouteChatClient.AssertExpectations(s.T())
+	s.RouteChatServer.AssertExpectations(s.T())
 }
 
-func (s *MockRouteGuideServer) ListFeatures(in *Rectangle, out RouteGuide_ListFeaturesServer) error {
-	args := s.Called(in, out)
-	return args.Error(0)
+// StubGetFeature stubs the GetFeature method of the client mock with any context.
+func (s *RouteGuideMockSuite) StubGetFeature(in interface{}, out *Feature, err error) *mock.Call {
+	return s.Client.OnGetFeature(mock.Anything, in).Return(out, err)
 }
 
-func (s *MockRouteGuideServer) OnListFeatures(in interface{}, out interface{}) *mock.Call {
-	return s.On("ListFeatures", in, out)
+// StubListFeatures stubs the ListFeatures method of the client mock with any context.
+// It returns the ListFeaturesClient stream mock of the suite.
+func (s *RouteGuideMockSuite) StubListFeatures(in interface{}) *mock.Call {
+	return s.Client.OnListFeatures(mock.Anything, in).Return(s.ListFeaturesClient, nil)
 }
 
-type MockRouteGuide_ListFeaturesServer struct {
-	mock.Mock
+// StubRecordRoute stubs the RecordRoute method of the client mock with any context.
+// It returns the RecordRouteClient stream mock of the suite.
+func (s *RouteGuideMockSuite) StubRecordRoute() *mock.Call {
+	return s.Client.OnRecordRoute(mock.Anything).Return(s.RecordRouteClient, nil)
 }
 
-func NewMockRouteGuide_ListFeaturesServer() *MockRouteGuide_ListFeaturesServer {
-	return &MockRouteGuide_ListFeaturesServer{}
+// StubRouteChat stubs the RouteChat method of the client mock with any context.
+// It returns the RouteChatClient stream mock of the suite.
+func (s *RouteGuideMockSuite) StubRouteChat() *mock.Call {
+	return s.Client.OnRouteChat(mock.Anything).Return(s.RouteChatClient, nil)
 }
 
-func (x *MockRouteGuide_ListFeaturesServer) SetHeader(md metadata.MD) error {
-	args := x.Called(md)
-	return args.Error(0)
+// StubServerGetFeature stubs the GetFeature method of the server mock with any context.
+func (s *RouteGuideMockSuite) StubServerGetFeature(in interface{}, out *Feature, err error) *mock.Call {
+	return s.Server.OnGetFeature(mock.Anything, in).Return(out, err)
 }
 
-func (x *MockRouteGuide_ListFeaturesServer) SendHeader(md metadata.MD) error {
-	args := x.Called(md)
-	return args.Error(0)
+// StubServerListFeatures stubs the ListFeatures method of the server mock with any stream.
+func (s *RouteGuideMockSuite) StubServerListFeatures(in interface{}, err error) *mock.Call {
+	return s.Server.OnListFeatures(in, mock.Anything).Return(err)
 }
 
-func (x *MockRouteGuide_ListFeaturesServer) SetTrailer(md metadata.MD) {
-	_ = x.Called(md)
+// StubServerRecordRoute stubs the RecordRoute method of the server mock with any stream.
+func (s *RouteGuideMockSuite) StubServerRecordRoute(err error) *mock.Call {
+	return s.Server.OnRecordRoute(mock.Anything).Return(err)
 }
 
-func (x *MockRouteGuide_ListFeaturesServer) Context() context.Context {
-	args := x.Called()
-	return args.Get(0).(context.Context)
+// StubServerRouteChat stubs the RouteChat method of the server mock with any stream.
+func (s *RouteGuideMockSuite) StubServerRouteChat(err error) *mock.Call {
+	return s.Server.OnRouteChat(mock.Anything).Return(err)
 }
 
-func (x *MockRouteGuide_ListFeaturesServer) SendMsg(m interface{}) error {
-	args := x.Called(m)
-	return args.Error(0)
+func CapturePoint() *testifymock.Captor[Point] {
+	return testifymock.NewCaptor[Point]()
 }
 
-func (x *MockRouteGuide_ListFeaturesServer) RecvMsg(m interface{}) error {
-	args := x.Called(m)
-	return args.Error(0)
+func CaptureRectangle() *testifymock.Captor[Rectangle] {
+	return testifymock.NewCaptor[Rectangle]()
 }
 
-func (x *MockRouteGuide_ListFeaturesServer) Send(m *Feature) error {
-	args := x.Called(m)
-	return args.Error(0)
+func CaptureFeature() *testifymock.Captor[Feature] {
+	return testifymock.NewCaptor[Feature]()
 }
 
-func (x *MockRouteGuide_ListFeaturesServer) OnSend(m interface{}) *mock.Call {
-	return x.On("Send", m)
+func CaptureRouteNote() *testifymock.Captor[RouteNote] {
+	return testifymock.NewCaptor[RouteNote]()
 }
 
-func (s *MockRouteGuideServer) RecordRoute(out RouteGuide_RecordRouteServer) error {
-	args := s.Called(out)
-	return args.Error(0)
+func CaptureRouteSummary() *testifymock.Captor[RouteSummary] {
+	return testifymock.NewCaptor[RouteSummary]()
 }
 
-func (s *MockRouteGuideServer) OnRecordRoute(out interface{}) *mock.Call {
-	return s.On("RecordRoute", out)
+// PointField is the name of a field of Point.
+type PointField struct {
+	name protoreflect.Name
 }
 
-type MockRouteGuide_RecordRouteServer struct {
-	mock.Mock
+// FieldName returns the name of the field.
+func (f PointField) FieldName() protoreflect.Name {
+	return f.name
 }
 
-func NewMockRouteGuide_RecordRouteServer() *MockRouteGuide_RecordRouteServer {
-	return &MockRouteGuide_RecordRouteServer{}
+var (
+	PointField_Latitude  = PointField{name: "latitude"}
+	PointField_Longitude = PointField{name: "longitude"}
+)
+
+func EqPointIgnoring(v *Point, fields ...PointField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
 }
 
-func (x *MockRouteGuide_RecordRouteServer) SetHeader(md metadata.MD) error {
-	args := x.Called(md)
-	return args.Error(0)
+func EqPointMasked(v *Point, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
 }
 
-func (x *MockRouteGuide_RecordRouteServer) SendHeader(md metadata.MD) error {
-	args := x.Called(md)
-	return args.Error(0)
+// RectangleField is the name of a field of Rectangle.
+type RectangleField struct {
+	name protoreflect.Name
 }
 
-func (x *MockRouteGuide_RecordRouteServer) SetTrailer(md metadata.MD) {
-	_ = x.Called(md)
+// FieldName returns the name of the field.
+func (f RectangleField) FieldName() protoreflect.Name {
+	return f.name
 }
 
-func (x *MockRouteGuide_RecordRouteServer) Context() context.Context {
-	args := x.Called()
-	return args.Get(0).(context.Context)
+var (
+	RectangleField_Lo = RectangleField{name: "lo"}
+	RectangleField_Hi = RectangleField{name: "hi"}
+)
+
+func EqRectangleIgnoring(v *Rectangle, fields ...RectangleField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
 }
 
-func (x *MockRouteGuide_RecordRouteServer) SendMsg(m interface{}) error {
-	args := x.Called(m)
-	return args.Error(0)
+func EqRectangleMasked(v *Rectangle, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
 }
 
-func (x *MockRouteGuide_RecordRouteServer) RecvMsg(m interface{}) error {
-	args := x.Called(m)
-	return args.Error(0)
+// FeatureField is the name of a field of Feature.
+type FeatureField struct {
+	name protoreflect.Name
 }
 
-func (x *MockRouteGuide_RecordRouteServer) Recv() (*Point, error) {
-	args := x.Called()
-	return args.Get(0).(*Point), args.Error(1)
+// FieldName returns the name of the field.
+func (f FeatureField) FieldName() protoreflect.Name {
+	return f.name
 }
 
-func (x *MockRouteGuide_RecordRouteServer) OnRecv() *mock.Call {
-	return x.On("Recv")
+var (
+	FeatureField_Name     = FeatureField{name: "name"}
+	FeatureField_Location = FeatureField{name: "location"}
+)
+
+func EqFeatureIgnoring(v *Feature, fields ...FeatureField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
 }
 
-func (x *MockRouteGuide_RecordRouteServer) SendAndClose(m *RouteSummary) error {
-	args := x.Called(m)
-	return args.Error(0)
+func EqFeatureMasked(v *Feature, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
 }
 
-func (x *MockRouteGuide_RecordRouteServer) OnSendAndClose(m interface{}) *mock.Call {
-	return x.On("SendAndClose", m)
+// RouteNoteField is the name of a field of RouteNote.
+type RouteNoteField struct {
+	name protoreflect.Name
 }
 
-func (s *MockRouteGuideServer) RouteChat(out RouteGuide_RouteChatServer) error {
-	args := s.Called(out)
-	return args.Error(0)
+// FieldName returns the name of the field.
+func (f RouteNoteField) FieldName() protoreflect.Name {
+	return f.name
 }
 
-func (s *MockRouteGuideServer) OnRouteChat(out interface{}) *mock.Call {
-	return s.On("RouteChat", out)
+var (
+	RouteNoteField_Location = RouteNoteField{name: "location"}
+	RouteNoteField_Message  = RouteNoteField{name: "message"}
+)
+
+func EqRouteNoteIgnoring(v *RouteNote, fields ...RouteNoteField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
 }
 
-type MockRouteGuide_RouteChatServer struct {
-	mock.Mock
+func EqRouteNoteMasked(v *RouteNote, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
 }
 
-func NewMockRouteGuide_RouteChatServer() *MockRouteGuide_RouteChatServer {
-	return &MockRouteGuide_RouteChatServer{}
+// RouteSummaryField is the name of a field of RouteSummary.
+type RouteSummaryField struct {
+	name protoreflect.Name
 }
 
-func (x *MockRouteGuide_RouteChatServer) SetHeader(md metadata.MD) error {
-	args := x.Called(md)
-	return args.Error(0)
+// FieldName returns the name of the field.
+func (f RouteSummaryField) FieldName() protoreflect.Name {
+	return f.name
 }
 
-func (x *MockRouteGuide_RouteChatServer) SendHeader(md metadata.MD) error {
-	args := x.Called(md)
-	return args.Error(0)
+var (
+	RouteSummaryField_PointCount   = RouteSummaryField{name: "point_count"}
+	RouteSummaryField_FeatureCount = RouteSummaryField{name: "feature_count"}
+	RouteSummaryField_Distance     = RouteSummaryField{name: "distance"}
+	RouteSummaryField_ElapsedTime  = RouteSummaryField{name: "elapsed_time"}
+)
+
+func EqRouteSummaryIgnoring(v *RouteSummary, fields ...RouteSummaryField) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.IgnoringFields(v, fields...)))
 }
 
-func (x *MockRouteGuide_RouteChatServer) SetTrailer(md metadata.MD) {
-	_ = x.Called(md)
+func EqRouteSummaryMasked(v *RouteSummary, mask *fieldmaskpb.FieldMask) interface{} {
+	return mock.MatchedBy(grpcmock.MustMatch(grpcmock.MaskedFields(v, mask)))
 }
 
-func (x *MockRouteGuide_RouteChatServer) Context() context.Context {
-	args := x.Called()
-	return args.Get(0).(context.Context)
+func EqualPoint(v *Point) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
 }
 
-func (x *MockRouteGuide_RouteChatServer) SendMsg(m interface{}) error {
-	args := x.Called(m)
-	return args.Error(0)
+func EqualRectangle(v *Rectangle) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
 }
 
-func (x *MockRouteGuide_RouteChatServer) RecvMsg(m interface{}) error {
-	args := x.Called(m)
-	return args.Error(0)
+func EqualFeature(v *Feature) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
 }
 
-func (x *MockRouteGuide_RouteChatServer) Recv() (*RouteNote, error) {
-	args := x.Called()
-	return args.Get(0).(*RouteNote), args.Error(1)
+func EqualRouteNote(v *RouteNote) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
 }
 
-func (x *MockRouteGuide_RouteChatServer) OnRecv() *mock.Call {
-	return x.On("Recv")
+func EqualRouteSummary(v *RouteSummary) types.GomegaMatcher {
+	return gomegamock.EqualProto(v)
 }
 
-func (x *MockRouteGuide_RouteChatServer) Send(m *RouteNote) error {
-	args := x.Called(m)
-	return args.Error(0)
+func HaveReceivedGetFeature(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(testifymock.Received, "GetFeature", args...)
+}
+
+func HaveReceivedListFeatures(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(testifymock.Received, "ListFeatures", args...)
+}
+
+func HaveReceivedRecordRoute(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(testifymock.Received, "RecordRoute", args...)
 }
 
-func (x *MockRouteGuide_RouteChatServer) OnSend(m interface{}) *mock.Call {
-	return x.On("Send", m)
+func HaveReceivedRouteChat(args ...interface{}) types.GomegaMatcher {
+	return gomegamock.HaveReceived(testifymock.Received, "RouteChat", args...)
 }
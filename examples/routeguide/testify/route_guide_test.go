@@ -2,10 +2,27 @@
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"math"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/testifymock"
 )
 
 const (
@@ -162,3 +179,602 @@ func TestRouteChat(t *testing.T) {
 func e7(coord float64) int32 {
 	return int32(coord * math.Pow10(7))
 }
+
+func TestRouteChatScript(t *testing.T) {
+	// Create a new mock client for the RouteGuide service.
+	m := NewMockRouteGuideClient()
+	defer m.AssertExpectations(t)
+
+	// Create the scripted conversation.
+	ctx := context.Background()
+	reply := &RouteNote{Location: DresdenCenter, Message: "Hello from Dresden"}
+	res := NewRouteGuide_RouteChatClientScript().
+		ExpectSend(EqRouteNote(DresdenNote)).
+		ThenRecv(reply).
+		ExpectCloseSend().
+		ThenEOF()
+	defer res.AssertExpectations(t)
+
+	// Set up the expectation.
+	m.OnRouteChat(ctx).Return(res, nil)
+
+	// Call the client.
+	r, err := m.RouteChat(ctx)
+	assert.NoError(t, err)
+
+	// Receive in the background, like a real chat client does.
+	received := make(chan *RouteNote, 1)
+	go func() {
+		defer close(received)
+		for {
+			rn, err := r.Recv()
+			if err != nil {
+				return
+			}
+			received <- rn
+		}
+	}()
+
+	// Use the client streaming handler.
+	assert.NoError(t, r.Send(DresdenNote))
+	assert.Equal(t, reply, <-received)
+	assert.NoError(t, r.CloseSend())
+
+	// The conversation ends with io.EOF.
+	_, ok := <-received
+	assert.False(t, ok)
+}
+
+func TestRouteChatScriptDiverged(t *testing.T) {
+	// Create the scripted conversation.
+	res := NewRouteGuide_RouteChatClientScript().
+		ExpectSend(EqRouteNote(DresdenNote)).
+		ThenEOF()
+
+	// Send an unexpected message.
+	err := res.Send(&RouteNote{Message: "Unexpected"})
+
+	// Check that the divergence is reported at the first step.
+	assert.ErrorIs(t, err, grpcmock.ErrDiverged)
+	assert.Contains(t, err.Error(), "step 1 of 2")
+
+	rec := &recorder{}
+	assert.False(t, res.AssertExpectations(rec))
+	assert.Len(t, rec.errors, 1)
+}
+
+// recorder records the errors reported to a grpcmock.TestingT.
+type recorder struct {
+	errors []string
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+// echoServer is a RouteGuideServer, which echoes all route notes.
+type echoServer struct {
+	UnimplementedRouteGuideServer
+}
+
+func (echoServer) RouteChat(stream RouteGuide_RouteChatServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	if err := stream.SendHeader(metadata.Pairs("echo", strings.Join(md.Get("user"), ","))); err != nil {
+		return err
+	}
+	stream.SetTrailer(metadata.Pairs("done", "true"))
+
+	for {
+		note, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(note); err != nil {
+			return err
+		}
+	}
+}
+
+func TestRouteChatPipe(t *testing.T) {
+	// Create the connected client and server streams.
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "user", "Felix")
+	client, server := NewRouteGuide_RouteChatPipe(ctx)
+
+	// Run the server implementation.
+	go func() { server.Finish(echoServer{}.RouteChat(server)) }()
+
+	// Check that the header is propagated.
+	header, err := client.Header()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Felix"}, header.Get("echo"))
+
+	// Use the client streaming handler.
+	assert.NoError(t, client.Send(DresdenNote))
+	rn, err := client.Recv()
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(DresdenNote, rn))
+	assert.NoError(t, client.CloseSend())
+
+	// Check that the stream ends with io.EOF and the trailer is propagated.
+	_, err = client.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+	assert.Equal(t, []string{"true"}, client.Trailer().Get("done"))
+}
+
+func TestRecordRoutePipeError(t *testing.T) {
+	// Create the connected client and server streams.
+	client, server := NewRouteGuide_RecordRoutePipe(context.Background())
+
+	// Run the unimplemented server.
+	go func() { server.Finish(UnimplementedRouteGuideServer{}.RecordRoute(server)) }()
+
+	// Check that the status of the server is returned.
+	_, err := client.CloseAndRecv()
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestRouteChatPipeHeaderAfterFinish(t *testing.T) {
+	// Create the connected client and server streams and finish the server.
+	client, server := NewRouteGuide_RouteChatPipe(context.Background())
+	assert.NoError(t, server.SetHeader(metadata.Pairs("user", "Felix")))
+	server.Finish(nil)
+
+	// Check that the header is returned, although the stream is canceled.
+	for i := 0; i < 100; i++ {
+		header, err := client.Header()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"Felix"}, header.Get("user"))
+	}
+}
+
+func TestMockClientConn(t *testing.T) {
+	// Register a mock server on the connection and create a client.
+	m := NewMockRouteGuideServer()
+	defer m.AssertExpectations(t)
+	conn := grpcmock.NewMockClientConn()
+	RegisterRouteGuideServer(conn, m)
+	client := NewRouteGuideClient(conn)
+
+	// Set up the expectation.
+	res := &Feature{Name: "Dresden", Location: DresdenCenter}
+	m.OnGetFeature(mock.Anything, EqPoint(DresdenCenter)).Return(res, nil)
+
+	// Call the client.
+	r, err := client.GetFeature(context.Background(), DresdenCenter)
+
+	// Check that the response is a copy of the one returned by the mock.
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(res, r))
+	assert.NotSame(t, res, r)
+
+	// Check that unknown methods are unimplemented.
+	_, err = NewRouteGuideClient(grpcmock.NewMockClientConn()).GetFeature(context.Background(), DresdenCenter)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestMockClientConnStream(t *testing.T) {
+	// Register the server implementation on the connection.
+	conn := grpcmock.NewMockClientConn()
+	RegisterRouteGuideServer(conn, echoServer{})
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "user", "Felix")
+	client, err := NewRouteGuideClient(conn).RouteChat(ctx)
+	assert.NoError(t, err)
+
+	// Check that the header is propagated.
+	header, err := client.Header()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Felix"}, header.Get("echo"))
+
+	// Use the client streaming handler.
+	assert.NoError(t, client.Send(DresdenNote))
+	rn, err := client.Recv()
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(DresdenNote, rn))
+	assert.NoError(t, client.CloseSend())
+
+	// Check that the stream ends with io.EOF.
+	_, err = client.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestMockClientConnHandleUnary(t *testing.T) {
+	// Stub the method on the connection.
+	conn := grpcmock.NewMockClientConn()
+	conn.HandleUnary("/routeguide.RouteGuide/GetFeature", func(ctx context.Context, req proto.Message) (proto.Message, error) {
+		return &Feature{Name: "Invalid \xff", Location: req.(*Point)}, nil
+	})
+
+	// Check that the invalid UTF-8 string of the response cannot be marshaled.
+	_, err := NewRouteGuideClient(conn).GetFeature(context.Background(), DresdenCenter)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestGetFeatureInterceptors(t *testing.T) {
+	// Create a new mock client for the RouteGuide service.
+	m := NewMockRouteGuideClient()
+	defer m.AssertExpectations(t)
+
+	// Wrap the mock with a client interceptor, which adds metadata to the call.
+	var methods []string
+	auth := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		methods = append(methods, method)
+		return invoker(metadata.AppendToOutgoingContext(ctx, "authorization", "token"), method, req, reply, cc, opts...)
+	}
+	client := NewRouteGuideClientWithInterceptors(m, grpcmock.ClientInterceptors{Unary: []grpc.UnaryClientInterceptor{auth}})
+
+	// Set up the expectation, matching the metadata added by the interceptor.
+	res := &Feature{Name: "Dresden", Location: DresdenCenter}
+	hasToken := mock.MatchedBy(func(ctx context.Context) bool {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		return len(md.Get("authorization")) == 1
+	})
+	m.OnGetFeature(hasToken, EqPoint(DresdenCenter)).Return(res, nil)
+
+	// Call the client.
+	r, err := client.GetFeature(context.Background(), DresdenCenter)
+
+	// Check that the interceptor ran with the full method name.
+	assert.NoError(t, err)
+	assert.Equal(t, res, r)
+	assert.Equal(t, []string{"/routeguide.RouteGuide/GetFeature"}, methods)
+}
+
+func TestGetFeatureInterceptorsReply(t *testing.T) {
+	// Create a new mock client for the RouteGuide service.
+	m := NewMockRouteGuideClient()
+	defer m.AssertExpectations(t)
+
+	// Wrap the mock with a client interceptor, which passes a used reply.
+	var replies []proto.Message
+	reuse := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		reply.(*Feature).Name = "Berlin"
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		replies = append(replies, proto.Clone(reply.(proto.Message)))
+		return err
+	}
+	client := NewRouteGuideClientWithInterceptors(m, grpcmock.ClientInterceptors{Unary: []grpc.UnaryClientInterceptor{reuse}})
+
+	// Check that the reply is reset to the response of the mock.
+	m.OnGetFeature(mock.Anything, EqPoint(DresdenCenter)).Return(&Feature{Location: DresdenCenter}, nil).Once()
+	_, err := client.GetFeature(context.Background(), DresdenCenter)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(&Feature{Location: DresdenCenter}, replies[0]))
+
+	// Check that a nil response fails like on a real connection.
+	m.OnGetFeature(mock.Anything, EqPoint(DresdenCenter)).Return((*Feature)(nil), nil).Once()
+	_, err = client.GetFeature(context.Background(), DresdenCenter)
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestGetFeatureServerInterceptors(t *testing.T) {
+	// Create a new mock server for the RouteGuide service.
+	m := NewMockRouteGuideServer()
+	defer m.AssertExpectations(t)
+
+	// Wrap the mock with a server interceptor, which returns a message of the wrong type.
+	wrong := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return &Point{}, nil
+	}
+	srv := NewRouteGuideServerWithInterceptors(m, grpcmock.ServerInterceptors{Unary: []grpc.UnaryServerInterceptor{wrong}})
+
+	// Check that the wrong result is an internal error instead of a nil response.
+	_, err := srv.GetFeature(context.Background(), DresdenCenter)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Contains(t, err.Error(), "*routeguide.Point")
+}
+
+func TestRouteChatServerInterceptors(t *testing.T) {
+	// Create a new mock server for the RouteGuide service.
+	m := NewMockRouteGuideServer()
+	defer m.AssertExpectations(t)
+
+	// Wrap the mock with a server interceptor, which rejects unauthenticated streams.
+	authz := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, _ := metadata.FromIncomingContext(ss.Context())
+		if len(md.Get("authorization")) == 0 {
+			return status.Errorf(codes.Unauthenticated, "%s requires authentication", info.FullMethod)
+		}
+		return handler(srv, ss)
+	}
+	srv := NewRouteGuideServerWithInterceptors(m, grpcmock.ServerInterceptors{Stream: []grpc.StreamServerInterceptor{authz}})
+
+	// Check that an unauthenticated stream does not reach the mock.
+	client, server := NewRouteGuide_RouteChatPipe(context.Background())
+	go func() { server.Finish(srv.RouteChat(server)) }()
+	_, err := client.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Contains(t, err.Error(), "/routeguide.RouteGuide/RouteChat")
+
+	// Check that an authenticated stream reaches the mock.
+	m.OnRouteChat(mock.Anything).Return(nil)
+	client, server = NewRouteGuide_RouteChatPipe(metadata.AppendToOutgoingContext(context.Background(), "authorization", "token"))
+	go func() { server.Finish(srv.RouteChat(server)) }()
+	_, err = client.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestLenientServer(t *testing.T) {
+	// Register a mock server, which stubs only GetFeature for Dresden.
+	m := NewMockRouteGuideServer()
+	defer m.AssertExpectations(t)
+	conn := grpcmock.NewMockClientConn()
+	RegisterRouteGuideServer(conn, m)
+	client := NewRouteGuideClient(conn)
+	m.OnGetFeature(mock.Anything, EqPoint(DresdenCenter)).Return(&Feature{Name: "Dresden"}, nil)
+
+	// Check that the stubbed call succeeds.
+	_, err := client.GetFeature(context.Background(), DresdenCenter)
+	assert.NoError(t, err)
+
+	// Check that the call with other arguments is unimplemented instead of panicking.
+	_, err = client.GetFeature(context.Background(), &Point{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	// Check that the unstubbed stream ends with io.EOF.
+	stream, err := client.RouteChat(context.Background())
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+
+	// Check that the unstubbed calls are recorded apart from the stubbed calls.
+	m.AssertNumberOfCalls(t, "GetFeature", 1)
+	m.AssertUnstubbedCalled(t, "GetFeature", mock.Anything, EqPoint(&Point{}))
+	m.AssertUnstubbedCalled(t, "RouteChat", mock.Anything)
+	assert.Len(t, m.UnstubbedCalls(), 2)
+	assert.Len(t, m.ExpectedCalls, 1)
+
+	// Check that the unstubbed calls are counted as calls, but not as stubs.
+	stubbed, called, unmatched := map[string]int{}, map[string]int{}, map[string][]string{}
+	m.expectations.CountCalls(stubbed, called, unmatched)
+	assert.Equal(t, map[string]int{"GetFeature": 1}, stubbed)
+	assert.Equal(t, map[string]int{"GetFeature": 2, "RouteChat": 1}, called)
+	assert.Empty(t, unmatched)
+}
+
+func TestLenientConcurrentCalls(t *testing.T) {
+	// Create a new mock client without any expectations.
+	m := NewMockRouteGuideClient()
+	m.OnGetFeature(mock.Anything, EqPoint(DresdenCenter)).Return(&Feature{Name: "Dresden"}, nil)
+
+	// Call the stubbed and the unstubbed method concurrently.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := m.GetFeature(context.Background(), DresdenCenter)
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := m.GetFeature(context.Background(), &Point{})
+			assert.Equal(t, codes.Unimplemented, status.Code(err))
+		}()
+	}
+	wg.Wait()
+
+	// Check that no expectations were added for the unstubbed calls.
+	m.AssertNumberOfCalls(t, "GetFeature", 10)
+	assert.Len(t, m.UnstubbedCalls(), 10)
+	assert.Len(t, m.ExpectedCalls, 1)
+}
+
+func TestLenientClient(t *testing.T) {
+	// Create a new mock client without any expectations.
+	m := NewMockRouteGuideClient()
+
+	// Check that the unary call is unimplemented.
+	_, err := m.GetFeature(context.Background(), DresdenCenter)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	// Check that the stream ends with io.EOF.
+	stream, err := m.ListFeatures(context.Background(), &Rectangle{})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.ErrorIs(t, err, io.EOF)
+
+	// Check that the unstubbed methods of the stream mocks end the stream.
+	_, err = NewMockRouteGuide_RouteChatClient().Recv()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+func TestInOrder(t *testing.T) {
+	// Create the mocks of the client and the stream.
+	m := NewMockRouteGuideClient()
+	stream := NewMockRouteGuide_RecordRouteClient()
+
+	// Expect the feature to be requested before the route is recorded.
+	seq := testifymock.InOrder(
+		m.OnGetFeature(mock.Anything, EqPoint(DresdenCenter)).Return(&Feature{Name: "Dresden"}, nil),
+		m.OnRecordRoute(mock.Anything).Return(stream, nil),
+		stream.OnSend(EqPoint(DresdenCenter)).Return(nil),
+		stream.OnCloseAndRecv().Return(&RouteSummary{PointCount: 1}, nil),
+	)
+
+	// Make the calls in order.
+	_, err := m.GetFeature(context.Background(), DresdenCenter)
+	assert.NoError(t, err)
+	s, err := m.RecordRoute(context.Background())
+	assert.NoError(t, err)
+	assert.NoError(t, s.Send(DresdenCenter))
+	_, err = s.CloseAndRecv()
+	assert.NoError(t, err)
+
+	seq.AssertExpectations(t)
+}
+
+func TestInOrderViolation(t *testing.T) {
+	// Create the mocks of the client and the stream.
+	m := NewMockRouteGuideClient()
+	stream := NewMockRouteGuide_RecordRouteClient()
+
+	// Expect the points to be sent before the summary is received.
+	rec := &recorder{}
+	seq := testifymock.InOrder(
+		stream.OnSend(mock.Anything).Return(nil),
+		stream.OnCloseAndRecv().Return(&RouteSummary{}, nil),
+	).Test(rec)
+	m.OnRecordRoute(mock.Anything).Return(stream, nil)
+
+	// Close the stream without sending any point.
+	s, err := m.RecordRoute(context.Background())
+	assert.NoError(t, err)
+	_, err = s.CloseAndRecv()
+	assert.NoError(t, err)
+
+	// Check that the violation is reported with the actual sequence.
+	assert.Len(t, rec.errors, 1)
+	assert.Contains(t, rec.errors[0], "call 2 of the sequence was out of order")
+	assert.Contains(t, rec.errors[0], "actual sequence:\n\t\t1: CloseAndRecv()")
+
+	rec = &recorder{}
+	assert.False(t, seq.AssertExpectations(rec))
+	assert.Len(t, rec.errors, 2)
+}
+
+func TestCaptor(t *testing.T) {
+	// Create a new mock server and capture the points.
+	m := NewMockRouteGuideServer()
+	defer m.AssertExpectations(t)
+	c := CapturePoint()
+	m.OnGetFeature(mock.Anything, c).Return(&Feature{}, nil)
+
+	// Call the server with two points.
+	_, _ = m.GetFeature(context.Background(), DresdenCenter)
+	_, _ = m.GetFeature(context.Background(), &Point{Latitude: 1})
+
+	// Check the captured points.
+	assert.Len(t, c.All(), 2)
+	assert.True(t, proto.Equal(DresdenCenter, c.All()[0]))
+	assert.Equal(t, int32(1), c.Last().GetLatitude())
+
+	// Only the calls matching the expectation of the captor are captured.
+	other := NewMockRouteGuideServer()
+	c = CapturePoint()
+	ctx := context.WithValue(context.Background(), t, "captured")
+	other.OnGetFeature(ctx, c).Return(&Feature{}, nil)
+	other.OnGetFeature(mock.Anything, mock.Anything).Return(&Feature{}, nil)
+	_, _ = other.GetFeature(context.Background(), DresdenCenter)
+	_, _ = other.GetFeature(ctx, &Point{Latitude: 1})
+	assert.Len(t, c.All(), 1)
+	assert.Equal(t, int32(1), c.Last().GetLatitude())
+
+	// Capture the messages sent on a stream.
+	stream := NewMockRouteGuide_RecordRouteClient()
+	sent := CapturePoint()
+	stream.OnSend(sent).Return(nil)
+	assert.NoError(t, stream.Send(DresdenCenter))
+	assert.Same(t, DresdenCenter, sent.Last())
+}
+
+func TestFieldMatchers(t *testing.T) {
+	// Create a stream mock, which ignores the message of the first note and
+	// only matches the latitude of the location of the second note.
+	stream := NewMockRouteGuide_RouteChatClient()
+	defer stream.AssertExpectations(t)
+	want := &RouteNote{Location: DresdenCenter, Message: "Hello"}
+	stream.OnSend(EqRouteNoteIgnoring(want, RouteNoteField_Message)).Return(nil).Once()
+	stream.OnSend(EqRouteNoteMasked(want, &fieldmaskpb.FieldMask{Paths: []string{"location.latitude"}})).Return(io.EOF).Once()
+
+	// Check that the notes match the expectations.
+	assert.NoError(t, stream.Send(&RouteNote{Location: DresdenCenter, Message: "Hi"}))
+	assert.ErrorIs(t, stream.Send(&RouteNote{Location: &Point{Latitude: DresdenCenter.Latitude}}), io.EOF)
+
+	// Check that invalid masks and fields are rejected.
+	_, err := grpcmock.MaskedFields(want, &fieldmaskpb.FieldMask{Paths: []string{"locaton"}})
+	assert.EqualError(t, err, "grpcmock: invalid field mask [locaton] for routeguide.RouteNote")
+	_, err = grpcmock.IgnoringFields(want, RouteNoteField{})
+	assert.EqualError(t, err, `grpcmock: unknown field "" of routeguide.RouteNote`)
+	assert.Panics(t, func() { EqRouteNoteMasked(want, &fieldmaskpb.FieldMask{Paths: []string{"locaton"}}) })
+	assert.Panics(t, func() { EqRouteNoteIgnoring(want, RouteNoteField{}) })
+}
+
+func TestGetFeatureGomega(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	// Create a new mock server for the RouteGuide service.
+	m := NewMockRouteGuideServer()
+
+	// Set up the expectation.
+	ctx := context.Background()
+	m.OnGetFeature(ctx, EqPoint(DresdenCenter)).Return(&Feature{Name: "Dresden", Location: DresdenCenter}, nil)
+
+	// Call the server.
+	r, err := m.GetFeature(ctx, &Point{Latitude: e7(DresdenLatitude), Longitude: e7(DresdenLongitude)})
+
+	// Check the response and the received request with Gomega.
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(r).To(EqualFeature(&Feature{Name: "Dresden", Location: DresdenCenter}))
+	g.Expect(m).To(HaveReceivedGetFeature(ctx, DresdenCenter))
+	g.Expect(m).NotTo(HaveReceivedGetFeature(ctx, &Point{}))
+	g.Expect(m).NotTo(HaveReceivedListFeatures(GermanyBoundingBox, mock.Anything))
+}
+
+// RouteGuideSuite uses the generated suite, which provides fresh mocks for each test.
+type RouteGuideSuite struct {
+	RouteGuideMockSuite
+}
+
+func TestRouteGuideSuite(t *testing.T) {
+	suite.Run(t, new(RouteGuideSuite))
+}
+
+func (s *RouteGuideSuite) TestGetFeature() {
+	// Set up the expectation with any context.
+	res := &Feature{Name: "Dresden", Location: DresdenCenter}
+	s.StubGetFeature(DresdenCenter, res, nil)
+
+	// Call the client.
+	r, err := s.Client.GetFeature(context.Background(), DresdenCenter)
+
+	// Check that the response is as expected.
+	s.NoError(err)
+	s.Equal(res, r)
+}
+
+func (s *RouteGuideSuite) TestListFeatures() {
+	// Set up the expectations, the client returns the stream mock of the suite.
+	feat := &Feature{Name: "Dresden", Location: DresdenCenter}
+	s.ListFeaturesClient.OnRecv().Return(feat, nil)
+	s.StubListFeatures(GermanyBoundingBox)
+
+	// Call the client and use the streaming handler.
+	r, err := s.Client.ListFeatures(context.Background(), GermanyBoundingBox)
+	s.Require().NoError(err)
+	f, err := r.Recv()
+
+	// Check that the streamed response is as expected.
+	s.NoError(err)
+	s.Equal(feat, f)
+}
+
+func (s *RouteGuideSuite) TestServerGetFeature() {
+	// Set up the expectation of the server with any context.
+	res := &Feature{Name: "Dresden", Location: DresdenCenter}
+	s.StubServerGetFeature(EqPoint(DresdenCenter), res, nil)
+
+	// Call the server through a client connection.
+	conn := grpcmock.NewMockClientConn()
+	RegisterRouteGuideServer(conn, s.Server)
+	r, err := NewRouteGuideClient(conn).GetFeature(context.Background(), DresdenCenter)
+
+	// Check that the response is as expected.
+	s.NoError(err)
+	s.True(proto.Equal(res, r))
+}
+
+func (s *RouteGuideSuite) TestServerRouteChat() {
+	// Set up the expectation of the server with any stream.
+	s.StubServerRouteChat(nil)
+
+	// Call the server with a pipe.
+	client, server := NewRouteGuide_RouteChatPipe(context.Background())
+	go func() { server.Finish(s.Server.RouteChat(server)) }()
+
+	// Check that the stream ends without an error.
+	_, err := client.Recv()
+	s.ErrorIs(err, io.EOF)
+}
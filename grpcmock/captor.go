@@ -0,0 +1,42 @@
+package grpcmock
+
+import "sync"
+
+// Captor captures the messages of type *T passed to a mock. The captors of the
+// mocking frameworks in the packages testifymock and pegomockmock embed it and
+// record the messages their argument matchers are matched against. The generated
+// Capture<Message> functions create captors for each message.
+type Captor[T any] struct {
+	mu     sync.Mutex
+	values []*T
+}
+
+// NewCaptor creates a captor without any captured messages.
+func NewCaptor[T any]() *Captor[T] {
+	return &Captor[T]{}
+}
+
+// Record captures the message. It is called by the argument matchers of the
+// captors of the mocking frameworks.
+func (c *Captor[T]) Record(v *T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values = append(c.values, v)
+}
+
+// Last returns the last captured message or nil, if no message was captured.
+func (c *Captor[T]) Last() *T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.values) == 0 {
+		return nil
+	}
+	return c.values[len(c.values)-1]
+}
+
+// All returns the captured messages in the order of the calls.
+func (c *Captor[T]) All() []*T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]*T(nil), c.values...)
+}
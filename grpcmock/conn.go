@@ -0,0 +1,183 @@
+package grpcmock
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// MockClientConn is a grpc.ClientConnInterface, which dispatches the calls by
+// their full method name to registered servers and handlers instead of sending
+// them over the network. It implements grpc.ServiceRegistrar, so the mock servers
+// are registered with the generated Register<Service>Server functions:
+//
+//	conn := grpcmock.NewMockClientConn()
+//	routeguide.RegisterRouteGuideServer(conn, routeguide.NewMockRouteGuideServer())
+//	client := routeguide.NewRouteGuideClient(conn)
+//
+// All messages are marshaled and unmarshaled on their way, so serialization
+// errors are reported like on a real connection. The outgoing metadata of the
+// client is passed to the server as incoming metadata.
+type MockClientConn struct {
+	mu      sync.RWMutex
+	methods map[string]*methodHandler
+}
+
+// methodHandler handles either a unary or a streaming method.
+type methodHandler struct {
+	unary  func(ctx context.Context, req proto.Message) (interface{}, error)
+	stream func(stream grpc.ServerStream) error
+}
+
+var (
+	_ grpc.ClientConnInterface = (*MockClientConn)(nil)
+	_ grpc.ServiceRegistrar    = (*MockClientConn)(nil)
+)
+
+// NewMockClientConn creates a connection without any registered methods.
+func NewMockClientConn() *MockClientConn {
+	return &MockClientConn{methods: make(map[string]*methodHandler)}
+}
+
+// RegisterService registers the implementation of the service. It panics, if
+// impl does not implement the service like grpc.Server.RegisterService.
+func (c *MockClientConn) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	if impl != nil {
+		ht := reflect.TypeOf(desc.HandlerType).Elem()
+		if st := reflect.TypeOf(impl); !st.Implements(ht) {
+			panic(fmt.Sprintf("grpcmock: RegisterService found the handler of type %v that does not satisfy %v", st, ht))
+		}
+	}
+
+	for i := range desc.Methods {
+		m := desc.Methods[i]
+		c.handle("/"+desc.ServiceName+"/"+m.MethodName, &methodHandler{
+			unary: func(ctx context.Context, req proto.Message) (interface{}, error) {
+				dec := func(v interface{}) error {
+					proto.Merge(v.(proto.Message), req)
+					return nil
+				}
+				return m.Handler(impl, ctx, dec, nil)
+			},
+		})
+	}
+	for i := range desc.Streams {
+		s := desc.Streams[i]
+		c.handle("/"+desc.ServiceName+"/"+s.StreamName, &methodHandler{
+			stream: func(stream grpc.ServerStream) error { return s.Handler(impl, stream) },
+		})
+	}
+}
+
+// HandleUnary stubs the unary method with the full name method, for example
+// "/routeguide.RouteGuide/GetFeature". The request has the type of the message
+// passed by the client and the response must have the type of its reply.
+func (c *MockClientConn) HandleUnary(method string, fn func(ctx context.Context, req proto.Message) (proto.Message, error)) {
+	c.handle(method, &methodHandler{
+		unary: func(ctx context.Context, req proto.Message) (interface{}, error) { return fn(ctx, req) },
+	})
+}
+
+// HandleStream stubs the streaming method with the full name method, for example
+// "/routeguide.RouteGuide/RouteChat". The stream is finished with the returned error.
+func (c *MockClientConn) HandleStream(method string, fn func(stream grpc.ServerStream) error) {
+	c.handle(method, &methodHandler{stream: fn})
+}
+
+func (c *MockClientConn) handle(method string, h *methodHandler) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.methods[method] = h
+}
+
+func (c *MockClientConn) lookup(method string) *methodHandler {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.methods[method]
+}
+
+// Invoke calls the unary method registered for method. The header and trailer
+// of the server are returned through grpc.Header and grpc.Trailer call options.
+func (c *MockClientConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	h := c.lookup(method)
+	if h == nil || h.unary == nil {
+		return status.Errorf(codes.Unimplemented, "grpcmock: unknown method %s", method)
+	}
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+
+	req, err := roundTrip(args.(proto.Message))
+	if err != nil {
+		return err
+	}
+
+	p, _, serverCtx := newPipe(ctx, method, true)
+	defer p.cancel()
+
+	res, err := h.unary(serverCtx, req)
+
+	p.mu.Lock()
+	header, trailer := p.header.Copy(), p.trailer.Copy()
+	p.mu.Unlock()
+	for _, opt := range opts {
+		switch opt := opt.(type) {
+		case grpc.HeaderCallOption:
+			*opt.HeaderAddr = header
+		case grpc.TrailerCallOption:
+			*opt.TrailerAddr = trailer
+		}
+	}
+
+	if err != nil {
+		return status.Convert(err).Err()
+	}
+	msg, ok := res.(proto.Message)
+	if !ok || msg == nil {
+		return status.Errorf(codes.Internal, "grpcmock: method %s returned %T, it is not a proto.Message", method, res)
+	}
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return status.Errorf(codes.Internal, "grpcmock: error while marshaling: %v", err)
+	}
+	if err := proto.Unmarshal(b, reply.(proto.Message)); err != nil {
+		return status.Errorf(codes.Internal, "grpcmock: error while unmarshaling: %v", err)
+	}
+	return nil
+}
+
+// NewStream starts the streaming method registered for method. The handler runs
+// in its own goroutine until it returns, which finishes the stream.
+func (c *MockClientConn) NewStream(ctx context.Context, _ *grpc.StreamDesc, method string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+	h := c.lookup(method)
+	if h == nil || h.stream == nil {
+		return nil, status.Errorf(codes.Unimplemented, "grpcmock: unknown method %s", method)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+
+	p, ctx, serverCtx := newPipe(ctx, method, true)
+	client, server := &ClientStream[any, any]{p: p, ctx: ctx}, &ServerStream[any, any]{p: p, ctx: serverCtx}
+	go func() { server.Finish(h.stream(server)) }()
+	return client, nil
+}
+
+// roundTrip copies the message by marshaling and unmarshaling it.
+func roundTrip(msg proto.Message) (proto.Message, error) {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "grpcmock: error while marshaling: %v", err)
+	}
+	out := msg.ProtoReflect().New().Interface()
+	if err := proto.Unmarshal(b, out); err != nil {
+		return nil, status.Errorf(codes.Internal, "grpcmock: error while unmarshaling: %v", err)
+	}
+	return out, nil
+}
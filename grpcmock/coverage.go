@@ -0,0 +1,199 @@
+package grpcmock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"text/tabwriter"
+)
+
+const (
+	// CoverageTextFile is the name of the text report written by RunWithCoverage.
+	CoverageTextFile = "grpcmock_coverage.txt"
+	// CoverageJSONFile is the name of the JSON report written by RunWithCoverage.
+	CoverageJSONFile = "grpcmock_coverage.json"
+)
+
+// The status of a method in the coverage report.
+const (
+	// MethodCalled is the status of a method, which was called on a mock.
+	MethodCalled = "called"
+	// MethodStubNotCalled is the status of a method, which was stubbed, but never called.
+	MethodStubNotCalled = "stubbed, not called"
+	// MethodUnused is the status of a method, which was neither stubbed nor called.
+	MethodUnused = "unused"
+)
+
+// coverage collects the mocks created while the coverage is enabled.
+var coverage = &collector{}
+
+type collector struct {
+	mu       sync.Mutex
+	enabled  bool
+	services map[string]*trackedService
+}
+
+type trackedService struct {
+	methods []string
+	mocks   []CallCounter
+}
+
+// A CallCounter counts the stubs and calls of the methods of a mock. The packages
+// testifymock and pegomockmock implement it for the mocks of their frameworks.
+type CallCounter interface {
+	// CountCalls adds the number of stubs and calls of each method of the mock
+	// and the descriptions of the stubs, which matched no call.
+	CountCalls(stubbed, called map[string]int, unmatched map[string][]string)
+}
+
+// TrackCoverage registers a mock of the service with the methods for the coverage
+// report. It is called by the TrackCoverage functions of the framework packages
+// and does nothing, unless the tests are run by RunWithCoverage.
+func TrackCoverage(service string, methods []string, m CallCounter) {
+	coverage.mu.Lock()
+	defer coverage.mu.Unlock()
+	if !coverage.enabled {
+		return
+	}
+	if coverage.services == nil {
+		coverage.services = make(map[string]*trackedService)
+	}
+	s, ok := coverage.services[service]
+	if !ok {
+		s = &trackedService{methods: methods}
+		coverage.services[service] = s
+	}
+	s.mocks = append(s.mocks, m)
+}
+
+// RunWithCoverage runs the tests with the coverage of the mocks enabled and writes
+// the report to CoverageTextFile and CoverageJSONFile in dir. It returns the exit
+// code of the tests run by m, which is a *testing.M in TestMain:
+//
+//	func TestMain(m *testing.M) {
+//		os.Exit(grpcmock.RunWithCoverage(m, "."))
+//	}
+func RunWithCoverage(m interface{ Run() int }, dir string) int {
+	coverage.mu.Lock()
+	coverage.enabled = true
+	coverage.mu.Unlock()
+
+	code := m.Run()
+
+	if err := CurrentCoverage().writeFiles(dir); err != nil {
+		fmt.Fprintf(os.Stderr, "grpcmock: writing coverage report: %v\n", err)
+		if code == 0 {
+			code = 1
+		}
+	}
+	return code
+}
+
+// CoverageReport is the report of the methods stubbed and called on the mocks of each service.
+type CoverageReport struct {
+	Services []ServiceCoverage `json:"services"`
+}
+
+// ServiceCoverage is the coverage of the methods of a service.
+type ServiceCoverage struct {
+	Service string           `json:"service"`
+	Methods []MethodCoverage `json:"methods"`
+}
+
+// MethodCoverage is the number of stubs and calls of a method across all mocks of
+// the service and the stubs, which matched no call.
+type MethodCoverage struct {
+	Method    string   `json:"method"`
+	Stubbed   int      `json:"stubbed"`
+	Called    int      `json:"called"`
+	Status    string   `json:"status"`
+	Unmatched []string `json:"unmatched,omitempty"`
+}
+
+// CurrentCoverage returns the report of the mocks created so far.
+func CurrentCoverage() *CoverageReport {
+	coverage.mu.Lock()
+	defer coverage.mu.Unlock()
+
+	names := make([]string, 0, len(coverage.services))
+	for name := range coverage.services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	report := &CoverageReport{Services: []ServiceCoverage{}}
+	for _, name := range names {
+		s := coverage.services[name]
+		stubbed, called, unmatched := make(map[string]int), make(map[string]int), make(map[string][]string)
+		for _, m := range s.mocks {
+			m.CountCalls(stubbed, called, unmatched)
+		}
+
+		sc := ServiceCoverage{Service: name}
+		for _, method := range s.methods {
+			mc := MethodCoverage{Method: method, Stubbed: stubbed[method], Called: called[method], Status: MethodUnused, Unmatched: unmatched[method]}
+			switch {
+			case mc.Called > 0:
+				mc.Status = MethodCalled
+			case mc.Stubbed > 0:
+				mc.Status = MethodStubNotCalled
+			}
+			sc.Methods = append(sc.Methods, mc)
+		}
+		report.Services = append(report.Services, sc)
+	}
+	return report
+}
+
+// WriteText writes the report as a table of the methods of each service. The
+// unmatched stubs are listed below the table.
+func (r *CoverageReport) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, s := range r.Services {
+		fmt.Fprintln(tw, s.Service)
+		fmt.Fprintln(tw, "\tMETHOD\tSTUBBED\tCALLED\tUNMATCHED\tSTATUS")
+		var unmatched []string
+		for _, m := range s.Methods {
+			fmt.Fprintf(tw, "\t%s\t%d\t%d\t%d\t%s\n", m.Method, m.Stubbed, m.Called, len(m.Unmatched), m.Status)
+			unmatched = append(unmatched, m.Unmatched...)
+		}
+		if len(unmatched) > 0 {
+			fmt.Fprintln(tw, "\tUNMATCHED STUBS")
+		}
+		for _, stub := range unmatched {
+			fmt.Fprintf(tw, "\t%s\n", stub)
+		}
+	}
+	return tw.Flush()
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *CoverageReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+func (r *CoverageReport) writeFiles(dir string) error {
+	for name, write := range map[string]func(io.Writer) error{
+		CoverageTextFile: r.WriteText,
+		CoverageJSONFile: r.WriteJSON,
+	} {
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			return err
+		}
+		if err := write(f); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
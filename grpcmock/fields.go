@@ -0,0 +1,98 @@
+package grpcmock
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// FieldName is implemented by the generated <Message>Field types. Their values
+// are the generated variables <Message>Field_<Field>, so the names of the fields
+// passed to the Eq<Message>Ignoring matchers are validated by the compiler.
+type FieldName interface {
+	FieldName() protoreflect.Name
+}
+
+// IgnoringFields returns a function, which reports whether a message is equal to
+// want according to proto.Equal, except for the fields. It returns an error, if
+// a field is not a field of the message.
+func IgnoringFields[T proto.Message, F FieldName](want T, fields ...F) (func(T) bool, error) {
+	desc := want.ProtoReflect().Descriptor()
+	fds := make([]protoreflect.FieldDescriptor, len(fields))
+	for i, field := range fields {
+		fds[i] = desc.Fields().ByName(field.FieldName())
+		if fds[i] == nil {
+			return nil, fmt.Errorf("grpcmock: unknown field %q of %s", field.FieldName(), desc.FullName())
+		}
+	}
+	return func(got T) bool {
+		return proto.Equal(clearFields(want, fds), clearFields(got, fds))
+	}, nil
+}
+
+// clearFields returns a copy of the message without the fields.
+func clearFields(msg proto.Message, fds []protoreflect.FieldDescriptor) proto.Message {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return msg
+	}
+	m := proto.Clone(msg).ProtoReflect()
+	for _, fd := range fds {
+		m.Clear(fd)
+	}
+	return m.Interface()
+}
+
+// MaskedFields returns a function, which reports whether the fields in the paths
+// of the mask are equal in a message and in want. It returns an error, if the
+// mask is not valid for the message.
+func MaskedFields[T proto.Message](want T, mask *fieldmaskpb.FieldMask) (func(T) bool, error) {
+	if !mask.IsValid(want) {
+		return nil, fmt.Errorf("grpcmock: invalid field mask %v for %s", mask.GetPaths(), want.ProtoReflect().Descriptor().FullName())
+	}
+	return func(got T) bool {
+		return proto.Equal(maskFields(want, mask), maskFields(got, mask))
+	}, nil
+}
+
+// MustMatch returns the predicate or panics with the error. The generated
+// Eq<Message>Ignoring and Eq<Message>Masked matchers wrap IgnoringFields and
+// MaskedFields with it, so invalid fields fail when the expectation is set up
+// instead of never matching.
+func MustMatch[T any](match func(T) bool, err error) func(T) bool {
+	if err != nil {
+		panic(err)
+	}
+	return match
+}
+
+// maskFields returns a copy of the message with only the fields in the paths of the mask.
+func maskFields(msg proto.Message, mask *fieldmaskpb.FieldMask) proto.Message {
+	if msg == nil || !msg.ProtoReflect().IsValid() {
+		return msg
+	}
+	src := msg.ProtoReflect()
+	dst := src.New()
+	for _, path := range mask.GetPaths() {
+		copyPath(src, dst, strings.Split(path, "."))
+	}
+	return dst.Interface()
+}
+
+// copyPath copies the field at the path from src to dst.
+func copyPath(src, dst protoreflect.Message, path []string) {
+	fd := src.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil || !src.Has(fd) {
+		return
+	}
+	if len(path) == 1 {
+		dst.Set(fd, src.Get(fd))
+		return
+	}
+	if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+		return
+	}
+	copyPath(src.Get(fd).Message(), dst.Mutable(fd).Message(), path[1:])
+}
@@ -0,0 +1,114 @@
+// Package gomegamock contains the Gomega matchers used by the code generated by
+// protoc-gen-go-grpcmock with gomega=true.
+package gomegamock
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/types"
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+)
+
+// EqualProto returns a Gomega matcher, which succeeds if the actual value is a
+// message equal to expected according to proto.Equal. On failure, the message
+// contains a diff of both messages in the text format.
+func EqualProto(expected proto.Message) types.GomegaMatcher {
+	return &equalProtoMatcher{expected: expected}
+}
+
+type equalProtoMatcher struct {
+	expected proto.Message
+}
+
+func (m *equalProtoMatcher) Match(actual interface{}) (bool, error) {
+	msg, ok := actual.(proto.Message)
+	if !ok && actual != nil {
+		return false, fmt.Errorf("EqualProto expects a proto.Message, got:\n%s", format.Object(actual, 1))
+	}
+	return proto.Equal(m.expected, msg), nil
+}
+
+func (m *equalProtoMatcher) FailureMessage(actual interface{}) string {
+	got, _ := actual.(proto.Message)
+	want, actualText := formatProto(m.expected), formatProto(got)
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(strings.TrimSuffix(want, "\n")),
+		B:        difflib.SplitLines(strings.TrimSuffix(actualText, "\n")),
+		FromFile: "expected",
+		ToFile:   "actual",
+		Context:  3,
+	})
+	return fmt.Sprintf("Expected\n%s\nto equal\n%s\nDiff:\n%s", indent(actualText), indent(want), diff)
+}
+
+func (m *equalProtoMatcher) NegatedFailureMessage(actual interface{}) string {
+	got, _ := actual.(proto.Message)
+	return fmt.Sprintf("Expected\n%s\nnot to equal\n%s", indent(formatProto(got)), indent(formatProto(m.expected)))
+}
+
+func indent(text string) string {
+	return format.IndentString(strings.TrimSuffix(text, "\n"), 1)
+}
+
+// formatProto formats the message in the multiline text format.
+func formatProto(m proto.Message) string {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return "<nil>\n"
+	}
+	text := strings.TrimSuffix(prototext.MarshalOptions{Multiline: true, Indent: "  "}.Format(m), "\n")
+	if text == "" {
+		return fmt.Sprintf("%s {}\n", m.ProtoReflect().Descriptor().FullName())
+	}
+	return fmt.Sprintf("%s {\n  %s\n}\n", m.ProtoReflect().Descriptor().FullName(), strings.ReplaceAll(text, "\n", "\n  "))
+}
+
+// A ReceivedFunc reports whether the mock received at least one call of the
+// method with arguments matching args. The message describes the actual calls
+// of the mock. The packages testifymock and pegomockmock implement it with their
+// Received functions.
+type ReceivedFunc func(mock interface{}, method string, args []interface{}) (received bool, message string, err error)
+
+// HaveReceived returns a Gomega matcher, which succeeds if the actual value is a
+// mock, that received at least one call of the method with arguments matching
+// args according to received. Like the expectations of the mocks, all arguments
+// must be given. They may be plain values, messages, which are compared with
+// proto.Equal, a grpcmock.Matcher or an argument matcher of the mocking framework.
+func HaveReceived(received ReceivedFunc, method string, args ...interface{}) types.GomegaMatcher {
+	return &receivedMatcher{received: received, method: method, args: args}
+}
+
+type receivedMatcher struct {
+	received ReceivedFunc
+	method   string
+	args     []interface{}
+	message  string
+}
+
+func (m *receivedMatcher) Match(actual interface{}) (bool, error) {
+	ok, message, err := m.received(actual, m.method, m.args)
+	if err != nil {
+		return false, fmt.Errorf("HaveReceived %v:\n%s", err, format.Object(actual, 1))
+	}
+	m.message = message
+	return ok, nil
+}
+
+func (m *receivedMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected %T to have received %s(%s)\n%s", actual, m.method, formatArgs(m.args), m.message)
+}
+
+func (m *receivedMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected %T not to have received %s(%s)", actual, m.method, formatArgs(m.args))
+}
+
+func formatArgs(args []interface{}) string {
+	s := make([]string, len(args))
+	for i, arg := range args {
+		s[i] = fmt.Sprintf("%v", arg)
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,43 @@
+// Package grpcmock contains the runtime support for the code generated by
+// protoc-gen-go-grpcmock. The generated mocks only contain thin, typed wrappers
+// around the helpers of this package.
+//
+// The package does not depend on a mocking framework. The helpers of the
+// frameworks are in the packages testifymock, pegomockmock and gomegamock.
+package grpcmock
+
+import (
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// TestingT is the subset of testing.TB used to report failures.
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+// tHelper is implemented by testing.TB to mark helper functions.
+type tHelper interface {
+	Helper()
+}
+
+// A Matcher decides whether an argument matches an expectation.
+// Both testify's mock.MatchedBy and pegomock's argument matchers implement it.
+type Matcher interface {
+	Matches(argument interface{}) bool
+}
+
+// Matches reports whether actual satisfies expected. The expected value may be
+// a Matcher or a plain value. Protocol buffer messages are compared with
+// proto.Equal, other values with reflect.DeepEqual.
+func Matches(expected, actual interface{}) bool {
+	if m, ok := expected.(Matcher); ok {
+		return m.Matches(actual)
+	}
+	if want, ok := expected.(proto.Message); ok {
+		got, ok := actual.(proto.Message)
+		return ok && proto.Equal(want, got)
+	}
+	return reflect.DeepEqual(expected, actual)
+}
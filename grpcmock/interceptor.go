@@ -0,0 +1,190 @@
+package grpcmock
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ClientInterceptors are the interceptors run by the generated
+// New<Service>ClientWithInterceptors clients before a call reaches the mock.
+// The interceptors are called with a nil *grpc.ClientConn.
+type ClientInterceptors struct {
+	Unary  []grpc.UnaryClientInterceptor
+	Stream []grpc.StreamClientInterceptor
+}
+
+// ServerInterceptors are the interceptors run by the generated
+// New<Service>ServerWithInterceptors servers before a call reaches the mock.
+type ServerInterceptors struct {
+	Unary  []grpc.UnaryServerInterceptor
+	Stream []grpc.StreamServerInterceptor
+}
+
+// InterceptUnaryClient runs the unary client interceptors for the method before
+// calling the client. The reply seen by the interceptors is a copy of the
+// response of the client, which is returned unless an interceptor handled the
+// call without invoking the client. A nil response of the client without an
+// error fails with codes.Internal like a real connection.
+func InterceptUnaryClient[Req, Res any](ctx context.Context, interceptors []grpc.UnaryClientInterceptor, method string, in *Req, opts []grpc.CallOption,
+	call func(context.Context, *Req, ...grpc.CallOption) (*Res, error),
+) (*Res, error) {
+	var res *Res
+	invoker := func(ctx context.Context, method string, req, reply interface{}, _ *grpc.ClientConn, opts ...grpc.CallOption) error {
+		var err error
+		res, err = call(ctx, req.(*Req), opts...)
+		if err != nil {
+			return err
+		}
+		src, ok := any(res).(proto.Message)
+		if !ok || res == nil {
+			return status.Errorf(codes.Internal, "grpcmock: method %s returned %T, it is not a proto.Message", method, res)
+		}
+		dst := reply.(proto.Message)
+		proto.Reset(dst)
+		proto.Merge(dst, src)
+		return nil
+	}
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], invoker
+		invoker = func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			return interceptor(ctx, method, req, reply, cc, next, opts...)
+		}
+	}
+
+	reply := new(Res)
+	if err := invoker(ctx, method, in, reply, nil, opts...); err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return reply, nil
+	}
+	return res, nil
+}
+
+// InterceptStreamClient runs the stream client interceptors for the method before
+// calling the client. If an interceptor wraps the stream of the client, the
+// wrapped stream is converted to S by wrap.
+func InterceptStreamClient[S grpc.ClientStream](ctx context.Context, interceptors []grpc.StreamClientInterceptor, desc *grpc.StreamDesc, method string, opts []grpc.CallOption,
+	call func(context.Context, ...grpc.CallOption) (S, error), wrap func(grpc.ClientStream) S,
+) (S, error) {
+	var streamer grpc.Streamer = func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return call(ctx, opts...)
+	}
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], streamer
+		streamer = func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return interceptor(ctx, desc, cc, method, next, opts...)
+		}
+	}
+
+	var zero S
+	stream, err := streamer(ctx, desc, nil, method, opts...)
+	if err != nil || stream == nil {
+		return zero, err
+	}
+	if s, ok := stream.(S); ok {
+		return s, nil
+	}
+	return wrap(stream), nil
+}
+
+// InterceptUnaryServer runs the unary server interceptors for the method before
+// calling the server. A result of the interceptors, which is not a *Res, fails
+// with codes.Internal.
+func InterceptUnaryServer[Req, Res any](ctx context.Context, interceptors []grpc.UnaryServerInterceptor, srv interface{}, method string, in *Req,
+	call func(context.Context, *Req) (*Res, error),
+) (*Res, error) {
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: method}
+	var handler grpc.UnaryHandler = func(ctx context.Context, req interface{}) (interface{}, error) {
+		return call(ctx, req.(*Req))
+	}
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+
+	res, err := handler(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	out, ok := res.(*Res)
+	if !ok {
+		return nil, status.Errorf(codes.Internal, "grpcmock: method %s returned %T instead of %T", method, res, out)
+	}
+	return out, nil
+}
+
+// InterceptStreamServer runs the stream server interceptors for the method before
+// calling the server. If an interceptor wraps the stream, the wrapped stream is
+// converted to S by wrap.
+func InterceptStreamServer[S grpc.ServerStream](interceptors []grpc.StreamServerInterceptor, srv interface{}, desc *grpc.StreamDesc, method string, stream S,
+	call func(S) error, wrap func(grpc.ServerStream) S,
+) error {
+	info := &grpc.StreamServerInfo{FullMethod: method, IsClientStream: desc.ClientStreams, IsServerStream: desc.ServerStreams}
+	var handler grpc.StreamHandler = func(_ interface{}, ss grpc.ServerStream) error {
+		if s, ok := ss.(S); ok {
+			return call(s)
+		}
+		return call(wrap(ss))
+	}
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+		handler = func(srv interface{}, ss grpc.ServerStream) error {
+			return interceptor(srv, ss, info, next)
+		}
+	}
+	return handler(srv, stream)
+}
+
+// InterceptedClientStream is a client stream wrapped by an interceptor.
+// It implements the generated <Service>_<Method>Client interfaces.
+type InterceptedClientStream[Req, Res any] struct {
+	grpc.ClientStream
+}
+
+// Send sends a message to the server.
+func (s *InterceptedClientStream[Req, Res]) Send(m *Req) error { return s.SendMsg(m) }
+
+// Recv receives a message from the server.
+func (s *InterceptedClientStream[Req, Res]) Recv() (*Res, error) {
+	m := new(Res)
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// CloseAndRecv closes the sending side of the stream and receives the response of the server.
+func (s *InterceptedClientStream[Req, Res]) CloseAndRecv() (*Res, error) {
+	if err := s.CloseSend(); err != nil {
+		return nil, err
+	}
+	return s.Recv()
+}
+
+// InterceptedServerStream is a server stream wrapped by an interceptor.
+// It implements the generated <Service>_<Method>Server interfaces.
+type InterceptedServerStream[Req, Res any] struct {
+	grpc.ServerStream
+}
+
+// Send sends a message to the client.
+func (s *InterceptedServerStream[Req, Res]) Send(m *Res) error { return s.SendMsg(m) }
+
+// SendAndClose sends the response of a client streaming method to the client.
+func (s *InterceptedServerStream[Req, Res]) SendAndClose(m *Res) error { return s.SendMsg(m) }
+
+// Recv receives a message from the client.
+func (s *InterceptedServerStream[Req, Res]) Recv() (*Req, error) {
+	m := new(Req)
+	if err := s.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
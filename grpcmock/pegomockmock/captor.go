@@ -0,0 +1,54 @@
+package pegomockmock
+
+import (
+	"fmt"
+
+	"github.com/petergtz/pegomock"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+)
+
+// Captor is an argument matcher, which matches any message of type *T and
+// captures the messages it is matched against. The generated Capture<Message>
+// functions create captors for each message. The mocks accept the value returned
+// by Capture in place of an argument:
+//
+//	c := CapturePoint()
+//	pegomock.When(m.GetFeature(pegomockmock.AnyArg[context.Context](), c.Capture())).ThenReturn(feature, nil)
+type Captor[T any] struct {
+	grpcmock.Captor[T]
+}
+
+// NewCaptor creates a captor without any captured messages.
+func NewCaptor[T any]() *Captor[T] {
+	return &Captor[T]{}
+}
+
+// Capture registers the captor as pegomock argument matcher. Like the matchers
+// generated by pegomock, it returns nil, which is passed to the mock in place of
+// the argument.
+func (c *Captor[T]) Capture() *T {
+	pegomock.RegisterMatcher(&captorMatcher[T]{c: c})
+	// The coverage report must not capture the calls it matches the stubs against.
+	recordMatcher(&captorMatcher[T]{})
+	return nil
+}
+
+// captorMatcher matches the messages of type *T and records them in c, unless
+// c is nil.
+type captorMatcher[T any] struct {
+	c *Captor[T]
+}
+
+func (m *captorMatcher[T]) Matches(param pegomock.Param) bool {
+	v, ok := param.(*T)
+	if ok && m.c != nil {
+		m.c.Record(v)
+	}
+	return ok
+}
+
+func (m *captorMatcher[T]) String() string {
+	var zero T
+	return fmt.Sprintf("Capture(%T)", &zero)
+}
@@ -0,0 +1,100 @@
+package pegomockmock
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/petergtz/pegomock"
+)
+
+// registered records the argument matchers registered by this package since the
+// last invocation of a mock, since pegomock does not expose the matchers it
+// registers globally. The generated mocks take them, when they are invoked, to
+// tell which matchers a stub made with pegomock.When was made with.
+var registered struct {
+	sync.Mutex
+	matchers []pegomock.ArgumentMatcher
+}
+
+// registerMatcher registers the matcher with pegomock and records it.
+func registerMatcher(matcher pegomock.ArgumentMatcher) {
+	pegomock.RegisterMatcher(matcher)
+	recordMatcher(matcher)
+}
+
+// recordMatcher records the matcher without registering it.
+func recordMatcher(matcher pegomock.ArgumentMatcher) {
+	registered.Lock()
+	defer registered.Unlock()
+	registered.matchers = append(registered.matchers, matcher)
+}
+
+// takeMatchers returns and forgets the recorded matchers.
+func takeMatchers() []pegomock.ArgumentMatcher {
+	registered.Lock()
+	defer registered.Unlock()
+	matchers := registered.matchers
+	registered.matchers = nil
+	return matchers
+}
+
+// ResetMatchers forgets the matchers recorded for the coverage report, which
+// pegomock takes without invoking a mock. It is called by the generated
+// verifiers before they verify the invocations of a mock.
+func ResetMatchers() {
+	takeMatchers()
+}
+
+// ArgThat registers a pegomock argument matcher, which matches arguments of
+// type T satisfying fn. Like the matchers generated by pegomock, it returns the
+// zero value of T, which is passed to the mock in place of the argument.
+func ArgThat[T any](fn func(T) bool) T {
+	registerMatcher(&funcMatcher[T]{fn: fn})
+	var zero T
+	return zero
+}
+
+type funcMatcher[T any] struct {
+	fn func(T) bool
+}
+
+func (m *funcMatcher[T]) Matches(param pegomock.Param) bool {
+	v, ok := param.(T)
+	return ok && m.fn(v)
+}
+
+func (m *funcMatcher[T]) String() string {
+	return fmt.Sprintf("ArgThat(%v)", typeOf[T]())
+}
+
+// AnyArg registers a pegomock argument matcher, which matches any argument of
+// type T. It is used by the generated Any<Type> matchers.
+func AnyArg[T any]() T {
+	registerMatcher(pegomock.NewAnyMatcher(typeOf[T]()))
+	var zero T
+	return zero
+}
+
+// EqArg registers a pegomock argument matcher, which matches arguments equal to
+// value. It is used by the generated Eq<Type> matchers.
+func EqArg[T any](value T) T {
+	registerMatcher(&pegomock.EqMatcher{Value: value})
+	var zero T
+	return zero
+}
+
+// NotEqArg registers a pegomock argument matcher, which matches arguments not
+// equal to value. It is used by the generated NotEq<Type> matchers.
+func NotEqArg[T any](value T) T {
+	registerMatcher(&pegomock.NotEqMatcher{Value: value})
+	var zero T
+	return zero
+}
+
+// MatchArg registers the pegomock argument matcher for an argument of type T.
+// It is used by the generated <Type>That matchers.
+func MatchArg[T any](matcher pegomock.ArgumentMatcher) T {
+	registerMatcher(matcher)
+	var zero T
+	return zero
+}
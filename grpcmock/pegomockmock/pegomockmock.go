@@ -0,0 +1,215 @@
+// Package pegomockmock contains the runtime support for the mocks generated by
+// protoc-gen-go-grpcmock with framework=pegomock.
+package pegomockmock
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/petergtz/pegomock"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+)
+
+// TrackCoverage registers the pegomock mock of the service with the methods and
+// its invocations for the coverage report of grpcmock.RunWithCoverage. It is
+// called by the constructors of the generated mocks.
+func TrackCoverage(service string, methods []string, m pegomock.Mock, invocations *Invocations) {
+	grpcmock.TrackCoverage(service, methods, counter{m: m, invocations: invocations})
+}
+
+// Invocations records the invocations of the methods of a pegomock mock and the
+// matchers registered for them by this package. The generated mocks record
+// with it. Unlike the invocations recorded by pegomock, it includes the
+// invocations made to stub a method with pegomock.When.
+type Invocations struct {
+	mu          sync.Mutex
+	invocations map[string][]invocation
+}
+
+// invocation is an invocation of a method. The matchers are nil, if the
+// invocation is a call or a stub made with parameters instead of matchers.
+// Stubs made with matchers, which are not registered by this package, are
+// unknown.
+type invocation struct {
+	params   []pegomock.Param
+	matchers []pegomock.ArgumentMatcher
+	unknown  bool
+}
+
+// Invoked records an invocation of the method with the parameters.
+func (i *Invocations) Invoked(method string, params []pegomock.Param) {
+	inv := invocation{params: params, matchers: takeMatchers()}
+	if len(inv.matchers) != 0 && len(inv.matchers) != len(params) {
+		inv = invocation{params: params, unknown: true}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.invocations == nil {
+		i.invocations = make(map[string][]invocation)
+	}
+	i.invocations[method] = append(i.invocations[method], inv)
+}
+
+// counter counts the stubs and calls of a pegomock mock. pegomock does not expose
+// its stubbings, but removes the invocation made by pegomock.When from the
+// invocations it verifies. So the invocations of a method verified by pegomock
+// are its calls and the other invocations are its stubs.
+type counter struct {
+	m           pegomock.Mock
+	invocations *Invocations
+}
+
+// CountCalls implements grpcmock.CallCounter. The stubs, whose matchers match
+// none of the calls, are reported as unmatched stubs. Like pegomock, the stubs
+// made with parameters match the calls with equal parameters.
+func (c counter) CountCalls(stubbed, called map[string]int, unmatched map[string][]string) {
+	c.invocations.mu.Lock()
+	defer c.invocations.mu.Unlock()
+	for method, invocations := range c.invocations.invocations {
+		calls := make(map[int][][]pegomock.Param)
+		for _, inv := range invocations {
+			if _, ok := calls[len(inv.params)]; !ok {
+				calls[len(inv.params)] = verifiedCalls(c.m, method, len(inv.params))
+				called[method] += len(calls[len(inv.params)])
+			}
+		}
+
+		// Each call is one of the invocations without matchers, the others are stubs.
+		claimed := make(map[int][]bool)
+		for n, params := range calls {
+			claimed[n] = make([]bool, len(params))
+		}
+		for _, inv := range invocations {
+			n := len(inv.params)
+			matchers := inv.matchers
+			if matchers == nil && !inv.unknown {
+				if claim(calls[n], claimed[n], inv.params) {
+					continue
+				}
+				matchers = eqMatchers(inv.params)
+			}
+			stubbed[method]++
+			if !inv.unknown && !matchesAny(matchers, calls[n]) {
+				unmatched[method] = append(unmatched[method], stubString(method, matchers))
+			}
+		}
+	}
+}
+
+// claim marks the first unclaimed call with the parameters as claimed and
+// reports whether it found one.
+func claim(calls [][]pegomock.Param, claimed []bool, params []pegomock.Param) bool {
+	for i, call := range calls {
+		if !claimed[i] && reflect.DeepEqual(call, params) {
+			claimed[i] = true
+			return true
+		}
+	}
+	return false
+}
+
+// eqMatchers returns the matchers pegomock.When creates for the parameters of a
+// stub made without matchers.
+func eqMatchers(params []pegomock.Param) []pegomock.ArgumentMatcher {
+	matchers := make([]pegomock.ArgumentMatcher, len(params))
+	for i, param := range params {
+		matchers[i] = &pegomock.EqMatcher{Value: param}
+	}
+	return matchers
+}
+
+// matchesAny reports whether the matchers match the parameters of any call.
+func matchesAny(matchers []pegomock.ArgumentMatcher, calls [][]pegomock.Param) bool {
+	for _, params := range calls {
+		if pegomock.Matchers(matchers).Matches(params) {
+			return true
+		}
+	}
+	return false
+}
+
+// stubString returns the description of a stub of the method with the matchers.
+func stubString(method string, matchers []pegomock.ArgumentMatcher) string {
+	s := make([]string, len(matchers))
+	for i, matcher := range matchers {
+		s[i] = matcher.String()
+		eq, ok := matcher.(*pegomock.EqMatcher)
+		if !ok {
+			continue
+		}
+		if m, ok := eq.Value.(proto.Message); ok {
+			s[i] = fmt.Sprintf("Eq(%T{%s})", m, prototext.MarshalOptions{}.Format(m))
+		} else {
+			s[i] = fmt.Sprintf("Eq(%v)", eq.Value)
+		}
+	}
+	return method + "(" + strings.Join(s, ", ") + ")"
+}
+
+// verifiedCalls returns the parameters of the invocations of the method with n
+// parameters verified by pegomock. The matchers are registered globally by
+// pegomock, so it must not be called while mocks are stubbed or verified.
+func verifiedCalls(m pegomock.Mock, method string, n int) [][]pegomock.Param {
+	var invocations []pegomock.MethodInvocation
+	pegomock.InterceptMockFailures(func() {
+		for i := 0; i < n; i++ {
+			pegomock.RegisterMatcher(anyParam{})
+		}
+		invocations = pegomock.GetGenericMockFrom(m).Verify(nil, pegomock.AtLeast(0), method, make([]pegomock.Param, n))
+	})
+	params := pegomock.GetGenericMockFrom(m).GetInvocationParams(invocations)
+	calls := make([][]pegomock.Param, len(invocations))
+	for i := range calls {
+		calls[i] = make([]pegomock.Param, n)
+		for u := range calls[i] {
+			calls[i][u] = params[u][i]
+		}
+	}
+	return calls
+}
+
+// anyParam is a pegomock argument matcher, which matches any parameter.
+type anyParam struct{}
+
+func (anyParam) Matches(pegomock.Param) bool { return true }
+func (anyParam) String() string              { return "Any()" }
+
+// Received reports whether the pegomock mock received at least one call of the
+// method with arguments matching args and returns the actual invocations of the
+// mock. It is passed to gomegamock.HaveReceived by the generated
+// HaveReceived<Method> matchers.
+func Received(m interface{}, method string, args []interface{}) (bool, string, error) {
+	pm, ok := m.(pegomock.Mock)
+	if !ok {
+		return false, "", fmt.Errorf("expected a pegomock mock, got %T", m)
+	}
+	var invocations []pegomock.MethodInvocation
+	pegomock.InterceptMockFailures(func() {
+		for _, arg := range args {
+			pegomock.RegisterMatcher(argument(arg))
+		}
+		invocations = pegomock.GetGenericMockFrom(pm).Verify(nil, pegomock.AtLeast(0), method, make([]pegomock.Param, len(args)))
+	})
+	return len(invocations) > 0, "Actual invocations:\n" + pegomock.SDumpInvocationsFor(pm), nil
+}
+
+// argument converts the argument to a pegomock argument matcher.
+func argument(arg interface{}) pegomock.ArgumentMatcher {
+	if m, ok := arg.(pegomock.ArgumentMatcher); ok {
+		return m
+	}
+	return &argumentMatcher{arg: arg}
+}
+
+type argumentMatcher struct {
+	arg interface{}
+}
+
+func (m *argumentMatcher) Matches(param pegomock.Param) bool { return grpcmock.Matches(m.arg, param) }
+func (m *argumentMatcher) String() string                    { return fmt.Sprintf("%v", m.arg) }
@@ -0,0 +1,442 @@
+package pegomockmock
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/petergtz/pegomock"
+	"google.golang.org/grpc/metadata"
+)
+
+// stream is the pegomock mock of a stream shared by the client and the
+// server streams. It is the mock, the invocations of all methods of the stream
+// are registered for, so stubbing and verification do not depend on the type
+// embedding the stream.
+type stream struct {
+	fail func(message string, callerSkip ...int)
+}
+
+func (mock *stream) SetFailHandler(fh pegomock.FailHandler) { mock.fail = fh }
+func (mock *stream) FailHandler() pegomock.FailHandler      { return mock.fail }
+
+func (mock *stream) invoke(method string, params []pegomock.Param, returnTypes ...reflect.Type) pegomock.ReturnValues {
+	if mock == nil {
+		panic("mock must not be nil. Use the generated constructor of the stream mock.")
+	}
+	// The streams are not covered, but must not leave their matchers to the next
+	// invocation of a covered mock.
+	takeMatchers()
+	return pegomock.GetGenericMockFrom(mock).Invoke(method, params, returnTypes)
+}
+
+func (mock *stream) Context() context.Context {
+	result := mock.invoke("Context", nil, typeOf[context.Context]())
+	return returned[context.Context](result, 0)
+}
+
+func (mock *stream) RecvMsg(m interface{}) error {
+	result := mock.invoke("RecvMsg", []pegomock.Param{m}, typeOf[error]())
+	return returned[error](result, 0)
+}
+
+func (mock *stream) SendMsg(m interface{}) error {
+	result := mock.invoke("SendMsg", []pegomock.Param{m}, typeOf[error]())
+	return returned[error](result, 0)
+}
+
+// send sends the message m with the method.
+func (mock *stream) send(method string, m interface{}) error {
+	result := mock.invoke(method, []pegomock.Param{m}, typeOf[error]())
+	return returned[error](result, 0)
+}
+
+// recv receives a message of the stream with the method.
+func recv[T any](mock *stream, method string) (*T, error) {
+	result := mock.invoke(method, nil, typeOf[*T](), typeOf[error]())
+	return returned[*T](result, 0), returned[error](result, 1)
+}
+
+// typeOf returns the type of T, even if T is an interface.
+func typeOf[T any]() reflect.Type {
+	return reflect.TypeOf((*T)(nil)).Elem()
+}
+
+// returned returns the result i of an invocation or the zero value of T, if the
+// invocation is not stubbed.
+func returned[T any](result pegomock.ReturnValues, i int) T {
+	var v T
+	if len(result) != 0 && result[i] != nil {
+		v = result[i].(T)
+	}
+	return v
+}
+
+// clientStream implements the methods of grpc.ClientStream.
+type clientStream struct {
+	stream
+}
+
+func (mock *clientStream) Header() (metadata.MD, error) {
+	result := mock.invoke("Header", nil, typeOf[metadata.MD](), typeOf[error]())
+	return returned[metadata.MD](result, 0), returned[error](result, 1)
+}
+
+func (mock *clientStream) Trailer() metadata.MD {
+	result := mock.invoke("Trailer", nil, typeOf[metadata.MD]())
+	return returned[metadata.MD](result, 0)
+}
+
+func (mock *clientStream) CloseSend() error {
+	result := mock.invoke("CloseSend", nil, typeOf[error]())
+	return returned[error](result, 0)
+}
+
+// serverStream implements the methods of grpc.ServerStream.
+type serverStream struct {
+	stream
+}
+
+func (mock *serverStream) SetHeader(md metadata.MD) error {
+	result := mock.invoke("SetHeader", []pegomock.Param{md}, typeOf[error]())
+	return returned[error](result, 0)
+}
+
+func (mock *serverStream) SendHeader(md metadata.MD) error {
+	result := mock.invoke("SendHeader", []pegomock.Param{md}, typeOf[error]())
+	return returned[error](result, 0)
+}
+
+func (mock *serverStream) SetTrailer(md metadata.MD) {
+	mock.invoke("SetTrailer", []pegomock.Param{md})
+}
+
+// ServerStreamingClient is the pegomock mock of the client stream of a
+// server streaming method. The generated mocks of the client streams embed it.
+type ServerStreamingClient[Res any] struct {
+	clientStream
+}
+
+func (mock *ServerStreamingClient[Res]) Recv() (*Res, error) {
+	return recv[Res](&mock.stream, "Recv")
+}
+
+// ClientStreamingClient is the pegomock mock of the client stream of a
+// client streaming method. The generated mocks of the client streams embed it.
+type ClientStreamingClient[Req, Res any] struct {
+	clientStream
+}
+
+func (mock *ClientStreamingClient[Req, Res]) Send(m *Req) error {
+	return mock.send("Send", m)
+}
+
+func (mock *ClientStreamingClient[Req, Res]) CloseAndRecv() (*Res, error) {
+	return recv[Res](&mock.stream, "CloseAndRecv")
+}
+
+// BidiStreamingClient is the pegomock mock of the client stream of a
+// bidirectional streaming method. The generated mocks of the client streams embed it.
+type BidiStreamingClient[Req, Res any] struct {
+	clientStream
+}
+
+func (mock *BidiStreamingClient[Req, Res]) Send(m *Req) error {
+	return mock.send("Send", m)
+}
+
+func (mock *BidiStreamingClient[Req, Res]) Recv() (*Res, error) {
+	return recv[Res](&mock.stream, "Recv")
+}
+
+// ServerStreamingServer is the pegomock mock of the server stream of a
+// server streaming method. The generated mocks of the server streams embed it.
+type ServerStreamingServer[Res any] struct {
+	serverStream
+}
+
+func (mock *ServerStreamingServer[Res]) Send(m *Res) error {
+	return mock.send("Send", m)
+}
+
+// ClientStreamingServer is the pegomock mock of the server stream of a
+// client streaming method. The generated mocks of the server streams embed it.
+type ClientStreamingServer[Req, Res any] struct {
+	serverStream
+}
+
+func (mock *ClientStreamingServer[Req, Res]) Recv() (*Req, error) {
+	return recv[Req](&mock.stream, "Recv")
+}
+
+func (mock *ClientStreamingServer[Req, Res]) SendAndClose(m *Res) error {
+	return mock.send("SendAndClose", m)
+}
+
+// BidiStreamingServer is the pegomock mock of the server stream of a
+// bidirectional streaming method. The generated mocks of the server streams embed it.
+type BidiStreamingServer[Req, Res any] struct {
+	serverStream
+}
+
+func (mock *BidiStreamingServer[Req, Res]) Recv() (*Req, error) {
+	return recv[Req](&mock.stream, "Recv")
+}
+
+func (mock *BidiStreamingServer[Req, Res]) Send(m *Res) error {
+	return mock.send("Send", m)
+}
+
+// streamVerifier verifies the invocations of the methods of a stream.
+type streamVerifier struct {
+	mock                   *stream
+	invocationCountMatcher pegomock.InvocationCountMatcher
+	inOrderContext         *pegomock.InOrderContext
+	timeout                time.Duration
+}
+
+func (verifier *streamVerifier) verify(method string, params ...pegomock.Param) []pegomock.MethodInvocation {
+	ResetMatchers()
+	return pegomock.GetGenericMockFrom(verifier.mock).Verify(verifier.inOrderContext, verifier.invocationCountMatcher, method, params, verifier.timeout)
+}
+
+func (verifier *streamVerifier) Context() {
+	verifier.verify("Context")
+}
+
+func (verifier *streamVerifier) SendMsg(m interface{}) *ArgVerification[interface{}] {
+	return verifyArg[interface{}](verifier, "SendMsg", m)
+}
+
+func (verifier *streamVerifier) RecvMsg(m interface{}) *ArgVerification[interface{}] {
+	return verifyArg[interface{}](verifier, "RecvMsg", m)
+}
+
+// verifyArg verifies the invocations of a method with an argument of type T.
+func verifyArg[T any](verifier *streamVerifier, method string, arg T) *ArgVerification[T] {
+	methodInvocations := verifier.verify(method, arg)
+	return &ArgVerification[T]{mock: verifier.mock, methodInvocations: methodInvocations}
+}
+
+// clientStreamVerifier verifies the invocations of the methods of grpc.ClientStream.
+type clientStreamVerifier struct {
+	streamVerifier
+}
+
+func (verifier *clientStreamVerifier) Header() {
+	verifier.verify("Header")
+}
+
+func (verifier *clientStreamVerifier) Trailer() {
+	verifier.verify("Trailer")
+}
+
+func (verifier *clientStreamVerifier) CloseSend() {
+	verifier.verify("CloseSend")
+}
+
+// serverStreamVerifier verifies the invocations of the methods of grpc.ServerStream.
+type serverStreamVerifier struct {
+	streamVerifier
+}
+
+func (verifier *serverStreamVerifier) SetHeader(md metadata.MD) *ArgVerification[metadata.MD] {
+	return verifyArg(&verifier.streamVerifier, "SetHeader", md)
+}
+
+func (verifier *serverStreamVerifier) SendHeader(md metadata.MD) *ArgVerification[metadata.MD] {
+	return verifyArg(&verifier.streamVerifier, "SendHeader", md)
+}
+
+func (verifier *serverStreamVerifier) SetTrailer(md metadata.MD) *ArgVerification[metadata.MD] {
+	return verifyArg(&verifier.streamVerifier, "SetTrailer", md)
+}
+
+// ArgVerification is the ongoing verification of a method of a stream with
+// an argument of type T. It returns the captured arguments of the invocations.
+type ArgVerification[T any] struct {
+	mock              *stream
+	methodInvocations []pegomock.MethodInvocation
+}
+
+func (c *ArgVerification[T]) GetCapturedArguments() T {
+	m := c.GetAllCapturedArguments()
+	return m[len(m)-1]
+}
+
+func (c *ArgVerification[T]) GetAllCapturedArguments() (_param0 []T) {
+	params := pegomock.GetGenericMockFrom(c.mock).GetInvocationParams(c.methodInvocations)
+	if len(params) > 0 {
+		_param0 = make([]T, len(c.methodInvocations))
+		for u, param := range params[0] {
+			_param0[u] = param.(T)
+		}
+	}
+	return
+}
+
+// ServerStreamingClientVerifier verifies the invocations of the methods of the client stream of a server streaming method.
+type ServerStreamingClientVerifier[Res any] struct {
+	clientStreamVerifier
+}
+
+func (verifier *ServerStreamingClientVerifier[Res]) Recv() {
+	verifier.verify("Recv")
+}
+
+func (mock *ServerStreamingClient[Res]) VerifyWasCalledOnce() *ServerStreamingClientVerifier[Res] {
+	return &ServerStreamingClientVerifier[Res]{clientStreamVerifier{streamVerifier{&mock.stream, pegomock.Times(1), nil, 0}}}
+}
+
+func (mock *ServerStreamingClient[Res]) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *ServerStreamingClientVerifier[Res] {
+	return &ServerStreamingClientVerifier[Res]{clientStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, 0}}}
+}
+
+func (mock *ServerStreamingClient[Res]) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *ServerStreamingClientVerifier[Res] {
+	return &ServerStreamingClientVerifier[Res]{clientStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, inOrderContext, 0}}}
+}
+
+func (mock *ServerStreamingClient[Res]) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *ServerStreamingClientVerifier[Res] {
+	return &ServerStreamingClientVerifier[Res]{clientStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, timeout}}}
+}
+
+// ClientStreamingClientVerifier verifies the invocations of the methods of the client stream of a client streaming method.
+type ClientStreamingClientVerifier[Req, Res any] struct {
+	clientStreamVerifier
+}
+
+func (verifier *ClientStreamingClientVerifier[Req, Res]) Send(m *Req) *ArgVerification[*Req] {
+	return verifyArg(&verifier.streamVerifier, "Send", m)
+}
+
+func (verifier *ClientStreamingClientVerifier[Req, Res]) CloseAndRecv() {
+	verifier.verify("CloseAndRecv")
+}
+
+func (mock *ClientStreamingClient[Req, Res]) VerifyWasCalledOnce() *ClientStreamingClientVerifier[Req, Res] {
+	return &ClientStreamingClientVerifier[Req, Res]{clientStreamVerifier{streamVerifier{&mock.stream, pegomock.Times(1), nil, 0}}}
+}
+
+func (mock *ClientStreamingClient[Req, Res]) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *ClientStreamingClientVerifier[Req, Res] {
+	return &ClientStreamingClientVerifier[Req, Res]{clientStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, 0}}}
+}
+
+func (mock *ClientStreamingClient[Req, Res]) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *ClientStreamingClientVerifier[Req, Res] {
+	return &ClientStreamingClientVerifier[Req, Res]{clientStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, inOrderContext, 0}}}
+}
+
+func (mock *ClientStreamingClient[Req, Res]) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *ClientStreamingClientVerifier[Req, Res] {
+	return &ClientStreamingClientVerifier[Req, Res]{clientStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, timeout}}}
+}
+
+// BidiStreamingClientVerifier verifies the invocations of the methods of the client stream of a bidirectional streaming method.
+type BidiStreamingClientVerifier[Req, Res any] struct {
+	clientStreamVerifier
+}
+
+func (verifier *BidiStreamingClientVerifier[Req, Res]) Send(m *Req) *ArgVerification[*Req] {
+	return verifyArg(&verifier.streamVerifier, "Send", m)
+}
+
+func (verifier *BidiStreamingClientVerifier[Req, Res]) Recv() {
+	verifier.verify("Recv")
+}
+
+func (mock *BidiStreamingClient[Req, Res]) VerifyWasCalledOnce() *BidiStreamingClientVerifier[Req, Res] {
+	return &BidiStreamingClientVerifier[Req, Res]{clientStreamVerifier{streamVerifier{&mock.stream, pegomock.Times(1), nil, 0}}}
+}
+
+func (mock *BidiStreamingClient[Req, Res]) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *BidiStreamingClientVerifier[Req, Res] {
+	return &BidiStreamingClientVerifier[Req, Res]{clientStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, 0}}}
+}
+
+func (mock *BidiStreamingClient[Req, Res]) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *BidiStreamingClientVerifier[Req, Res] {
+	return &BidiStreamingClientVerifier[Req, Res]{clientStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, inOrderContext, 0}}}
+}
+
+func (mock *BidiStreamingClient[Req, Res]) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *BidiStreamingClientVerifier[Req, Res] {
+	return &BidiStreamingClientVerifier[Req, Res]{clientStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, timeout}}}
+}
+
+// ServerStreamingServerVerifier verifies the invocations of the methods of the server stream of a server streaming method.
+type ServerStreamingServerVerifier[Res any] struct {
+	serverStreamVerifier
+}
+
+func (verifier *ServerStreamingServerVerifier[Res]) Send(m *Res) *ArgVerification[*Res] {
+	return verifyArg(&verifier.streamVerifier, "Send", m)
+}
+
+func (mock *ServerStreamingServer[Res]) VerifyWasCalledOnce() *ServerStreamingServerVerifier[Res] {
+	return &ServerStreamingServerVerifier[Res]{serverStreamVerifier{streamVerifier{&mock.stream, pegomock.Times(1), nil, 0}}}
+}
+
+func (mock *ServerStreamingServer[Res]) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *ServerStreamingServerVerifier[Res] {
+	return &ServerStreamingServerVerifier[Res]{serverStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, 0}}}
+}
+
+func (mock *ServerStreamingServer[Res]) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *ServerStreamingServerVerifier[Res] {
+	return &ServerStreamingServerVerifier[Res]{serverStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, inOrderContext, 0}}}
+}
+
+func (mock *ServerStreamingServer[Res]) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *ServerStreamingServerVerifier[Res] {
+	return &ServerStreamingServerVerifier[Res]{serverStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, timeout}}}
+}
+
+// ClientStreamingServerVerifier verifies the invocations of the methods of the server stream of a client streaming method.
+type ClientStreamingServerVerifier[Req, Res any] struct {
+	serverStreamVerifier
+}
+
+func (verifier *ClientStreamingServerVerifier[Req, Res]) Recv() {
+	verifier.verify("Recv")
+}
+
+func (verifier *ClientStreamingServerVerifier[Req, Res]) SendAndClose(m *Res) *ArgVerification[*Res] {
+	return verifyArg(&verifier.streamVerifier, "SendAndClose", m)
+}
+
+func (mock *ClientStreamingServer[Req, Res]) VerifyWasCalledOnce() *ClientStreamingServerVerifier[Req, Res] {
+	return &ClientStreamingServerVerifier[Req, Res]{serverStreamVerifier{streamVerifier{&mock.stream, pegomock.Times(1), nil, 0}}}
+}
+
+func (mock *ClientStreamingServer[Req, Res]) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *ClientStreamingServerVerifier[Req, Res] {
+	return &ClientStreamingServerVerifier[Req, Res]{serverStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, 0}}}
+}
+
+func (mock *ClientStreamingServer[Req, Res]) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *ClientStreamingServerVerifier[Req, Res] {
+	return &ClientStreamingServerVerifier[Req, Res]{serverStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, inOrderContext, 0}}}
+}
+
+func (mock *ClientStreamingServer[Req, Res]) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *ClientStreamingServerVerifier[Req, Res] {
+	return &ClientStreamingServerVerifier[Req, Res]{serverStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, timeout}}}
+}
+
+// BidiStreamingServerVerifier verifies the invocations of the methods of the server stream of a bidirectional streaming method.
+type BidiStreamingServerVerifier[Req, Res any] struct {
+	serverStreamVerifier
+}
+
+func (verifier *BidiStreamingServerVerifier[Req, Res]) Recv() {
+	verifier.verify("Recv")
+}
+
+func (verifier *BidiStreamingServerVerifier[Req, Res]) Send(m *Res) *ArgVerification[*Res] {
+	return verifyArg(&verifier.streamVerifier, "Send", m)
+}
+
+func (mock *BidiStreamingServer[Req, Res]) VerifyWasCalledOnce() *BidiStreamingServerVerifier[Req, Res] {
+	return &BidiStreamingServerVerifier[Req, Res]{serverStreamVerifier{streamVerifier{&mock.stream, pegomock.Times(1), nil, 0}}}
+}
+
+func (mock *BidiStreamingServer[Req, Res]) VerifyWasCalled(invocationCountMatcher pegomock.InvocationCountMatcher) *BidiStreamingServerVerifier[Req, Res] {
+	return &BidiStreamingServerVerifier[Req, Res]{serverStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, 0}}}
+}
+
+func (mock *BidiStreamingServer[Req, Res]) VerifyWasCalledInOrder(invocationCountMatcher pegomock.InvocationCountMatcher, inOrderContext *pegomock.InOrderContext) *BidiStreamingServerVerifier[Req, Res] {
+	return &BidiStreamingServerVerifier[Req, Res]{serverStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, inOrderContext, 0}}}
+}
+
+func (mock *BidiStreamingServer[Req, Res]) VerifyWasCalledEventually(invocationCountMatcher pegomock.InvocationCountMatcher, timeout time.Duration) *BidiStreamingServerVerifier[Req, Res] {
+	return &BidiStreamingServerVerifier[Req, Res]{serverStreamVerifier{streamVerifier{&mock.stream, invocationCountMatcher, nil, timeout}}}
+}
@@ -0,0 +1,404 @@
+package grpcmock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	errSendAfterClose = status.Error(codes.Internal, "grpcmock: SendMsg called after CloseSend")
+	errHeaderSent     = status.Error(codes.Internal, "grpcmock: the header was already sent")
+	errFinished       = status.Error(codes.Internal, "grpcmock: the stream is already finished")
+)
+
+// NewPipe creates a connected pair of streams for the given full method name.
+// Messages sent on the ClientStream are received by the ServerStream and vice
+// versa. All messages are copied, so the streams behave like a network connection.
+//
+// The server side of the RPC ends by calling ServerStream.Finish with the error
+// returned by the handler, for example:
+//
+//	client, server := grpcmock.NewPipe[RouteNote, RouteNote](ctx, "/routeguide.RouteGuide/RouteChat")
+//	go func() { server.Finish(srv.RouteChat(server)) }()
+//
+// The outgoing metadata of ctx is passed to the server as incoming metadata.
+// Canceling ctx cancels both sides of the stream.
+func NewPipe[Req, Res any](ctx context.Context, method string) (*ClientStream[Req, Res], *ServerStream[Req, Res]) {
+	p, ctx, serverCtx := newPipe(ctx, method, false)
+	return &ClientStream[Req, Res]{p: p, ctx: ctx}, &ServerStream[Req, Res]{p: p, ctx: serverCtx}
+}
+
+// newPipe creates a pipe and returns it with the contexts of the client and the server.
+func newPipe(ctx context.Context, method string, marshal bool) (*pipe, context.Context, context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	p := &pipe{
+		method:     method,
+		cancel:     cancel,
+		marshal:    marshal,
+		headerSent: make(chan struct{}),
+		toServer:   newQueue(),
+		toClient:   newQueue(),
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	serverCtx := metadata.NewIncomingContext(ctx, md.Copy())
+	serverCtx = grpc.NewContextWithServerTransportStream(serverCtx, &serverTransportStream{p: p})
+
+	return p, ctx, serverCtx
+}
+
+// pipe holds the state shared by both sides of the stream.
+type pipe struct {
+	method string
+	cancel context.CancelFunc
+
+	// marshal copies the messages by marshaling them, like a network connection.
+	marshal bool
+
+	mu         sync.Mutex
+	header     metadata.MD
+	headerSent chan struct{}
+	trailer    metadata.MD
+	finished   bool
+
+	toServer *queue
+	toClient *queue
+}
+
+// sendHeader sends the header to the client, unless it was already sent.
+// The caller must hold the lock.
+func (p *pipe) sendHeader() {
+	select {
+	case <-p.headerSent:
+	default:
+		close(p.headerSent)
+	}
+}
+
+func (p *pipe) isHeaderSent() bool {
+	select {
+	case <-p.headerSent:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *pipe) setHeader(md metadata.MD, send bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.isHeaderSent() {
+		return errHeaderSent
+	}
+	p.header = metadata.Join(p.header, md)
+	if send {
+		p.sendHeader()
+	}
+	return nil
+}
+
+func (p *pipe) setTrailer(md metadata.MD) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.trailer = metadata.Join(p.trailer, md)
+}
+
+// copy copies the message sent on the pipe.
+func (p *pipe) copy(m interface{}) (proto.Message, error) {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		panic(fmt.Sprintf("grpcmock: cannot send %T, it is not a proto.Message", m))
+	}
+	if !p.marshal {
+		return proto.Clone(msg), nil
+	}
+	return roundTrip(msg)
+}
+
+// A ClientStream is the client side of a pipe created by NewPipe.
+// It implements the generated <Service>_<Method>Client interfaces.
+type ClientStream[Req, Res any] struct {
+	p   *pipe
+	ctx context.Context
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// Header returns the header sent by the server. It blocks until the header
+// was sent, the server sent a message or finished the stream.
+func (c *ClientStream[Req, Res]) Header() (metadata.MD, error) {
+	// The header wins over the canceled context, since both are ready after
+	// the stream finished.
+	if !c.p.isHeaderSent() {
+		select {
+		case <-c.p.headerSent:
+		case <-c.ctx.Done():
+			return nil, status.FromContextError(c.ctx.Err()).Err()
+		}
+	}
+	c.p.mu.Lock()
+	defer c.p.mu.Unlock()
+	return c.p.header.Copy(), nil
+}
+
+// Trailer returns the trailer set by the server. It must only be called after
+// Recv returned an error.
+func (c *ClientStream[Req, Res]) Trailer() metadata.MD {
+	c.p.mu.Lock()
+	defer c.p.mu.Unlock()
+	if !c.p.finished {
+		return nil
+	}
+	return c.p.trailer.Copy()
+}
+
+// CloseSend closes the sending side of the stream. The server receives io.EOF.
+func (c *ClientStream[Req, Res]) CloseSend() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.closed {
+		c.closed = true
+		c.p.toServer.close(nil)
+	}
+	return nil
+}
+
+// Context returns the context of the client.
+func (c *ClientStream[Req, Res]) Context() context.Context { return c.ctx }
+
+// SendMsg sends a message to the server.
+func (c *ClientStream[Req, Res]) SendMsg(m interface{}) error {
+	c.mu.Lock()
+	closed := c.closed
+	c.mu.Unlock()
+	if closed {
+		return errSendAfterClose
+	}
+	c.p.mu.Lock()
+	finished := c.p.finished
+	c.p.mu.Unlock()
+	if !finished {
+		if err := c.ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+	}
+	msg, err := c.p.copy(m)
+	if err != nil {
+		return err
+	}
+	if finished || !c.p.toServer.send(msg) {
+		// The server finished the stream, the status is returned by RecvMsg.
+		return io.EOF
+	}
+	return nil
+}
+
+// RecvMsg receives a message from the server.
+func (c *ClientStream[Req, Res]) RecvMsg(m interface{}) error {
+	msg, err := c.p.toClient.recv(c.ctx)
+	if err != nil {
+		return err
+	}
+	proto.Merge(m.(proto.Message), msg)
+	return nil
+}
+
+// Send sends a message to the server.
+func (c *ClientStream[Req, Res]) Send(m *Req) error { return c.SendMsg(m) }
+
+// Recv receives a message from the server.
+func (c *ClientStream[Req, Res]) Recv() (*Res, error) {
+	msg, err := c.p.toClient.recv(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return any(msg).(*Res), nil
+}
+
+// CloseAndRecv closes the sending side of the stream and receives the response of the server.
+func (c *ClientStream[Req, Res]) CloseAndRecv() (*Res, error) {
+	if err := c.CloseSend(); err != nil {
+		return nil, err
+	}
+	return c.Recv()
+}
+
+// A ServerStream is the server side of a pipe created by NewPipe.
+// It implements the generated <Service>_<Method>Server interfaces.
+type ServerStream[Req, Res any] struct {
+	p   *pipe
+	ctx context.Context
+}
+
+// SetHeader sets the header sent to the client. It may be called multiple times
+// to add metadata, but fails once the header was sent.
+func (s *ServerStream[Req, Res]) SetHeader(md metadata.MD) error { return s.p.setHeader(md, false) }
+
+// SendHeader sends the header to the client.
+func (s *ServerStream[Req, Res]) SendHeader(md metadata.MD) error { return s.p.setHeader(md, true) }
+
+// SetTrailer sets the trailer sent to the client, when the stream is finished.
+func (s *ServerStream[Req, Res]) SetTrailer(md metadata.MD) { s.p.setTrailer(md) }
+
+// Context returns the context of the server. It contains the outgoing metadata of
+// the client as incoming metadata.
+func (s *ServerStream[Req, Res]) Context() context.Context { return s.ctx }
+
+// SendMsg sends a message to the client. The header is sent implicitly.
+func (s *ServerStream[Req, Res]) SendMsg(m interface{}) error {
+	if err := s.ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	msg, err := s.p.copy(m)
+	if err != nil {
+		return err
+	}
+	s.p.mu.Lock()
+	s.p.sendHeader()
+	finished := s.p.finished
+	s.p.mu.Unlock()
+	if finished || !s.p.toClient.send(msg) {
+		return errFinished
+	}
+	return nil
+}
+
+// RecvMsg receives a message from the client.
+func (s *ServerStream[Req, Res]) RecvMsg(m interface{}) error {
+	msg, err := s.p.toServer.recv(s.ctx)
+	if err != nil {
+		return err
+	}
+	proto.Merge(m.(proto.Message), msg)
+	return nil
+}
+
+// Send sends a message to the client.
+func (s *ServerStream[Req, Res]) Send(m *Res) error { return s.SendMsg(m) }
+
+// SendAndClose sends the response of a client streaming method to the client.
+func (s *ServerStream[Req, Res]) SendAndClose(m *Res) error { return s.SendMsg(m) }
+
+// Recv receives a message from the client.
+func (s *ServerStream[Req, Res]) Recv() (*Req, error) {
+	msg, err := s.p.toServer.recv(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return any(msg).(*Req), nil
+}
+
+// Finish ends the RPC with the error returned by the handler. The client receives
+// io.EOF after all messages, if err is nil, or the status of err otherwise.
+func (s *ServerStream[Req, Res]) Finish(err error) {
+	s.p.mu.Lock()
+	defer s.p.mu.Unlock()
+	if s.p.finished {
+		return
+	}
+	s.p.finished = true
+	s.p.sendHeader()
+	if err != nil {
+		err = status.Convert(err).Err()
+	}
+	s.p.toClient.close(err)
+	s.p.toServer.close(errFinished)
+	s.p.cancel()
+}
+
+// serverTransportStream makes the header and trailer of the pipe available through
+// grpc.SetHeader, grpc.SendHeader and grpc.SetTrailer.
+type serverTransportStream struct {
+	p *pipe
+}
+
+func (s *serverTransportStream) Method() string                  { return s.p.method }
+func (s *serverTransportStream) SetHeader(md metadata.MD) error  { return s.p.setHeader(md, false) }
+func (s *serverTransportStream) SendHeader(md metadata.MD) error { return s.p.setHeader(md, true) }
+func (s *serverTransportStream) SetTrailer(md metadata.MD) error {
+	s.p.setTrailer(md)
+	return nil
+}
+
+// queue is a single direction of a pipe.
+type queue struct {
+	mu     sync.Mutex
+	msgs   []proto.Message
+	closed bool
+	err    error
+	ready  chan struct{}
+}
+
+func newQueue() *queue {
+	return &queue{ready: make(chan struct{})}
+}
+
+// send adds the copied message to the queue. It reports false, if the queue is closed.
+func (q *queue) send(msg proto.Message) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return false
+	}
+	q.msgs = append(q.msgs, msg)
+	q.notify()
+	return true
+}
+
+// close closes the queue. Receivers get err, or io.EOF if err is nil, after all messages.
+func (q *queue) close(err error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	q.closed = true
+	q.err = err
+	q.notify()
+}
+
+// recv receives the next message. It blocks until a message is available,
+// the queue is closed or the context is done.
+func (q *queue) recv(ctx context.Context) (proto.Message, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for {
+		if len(q.msgs) > 0 {
+			msg := q.msgs[0]
+			q.msgs = q.msgs[1:]
+			return msg, nil
+		}
+		if q.closed {
+			if q.err != nil {
+				return nil, q.err
+			}
+			return nil, io.EOF
+		}
+
+		ready := q.ready
+		q.mu.Unlock()
+		select {
+		case <-ready:
+			q.mu.Lock()
+		case <-ctx.Done():
+			q.mu.Lock()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+}
+
+// notify wakes up all waiting receivers. The caller must hold the lock.
+func (q *queue) notify() {
+	close(q.ready)
+	q.ready = make(chan struct{})
+}
@@ -0,0 +1,313 @@
+package grpcmock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultScriptTimeout is the time a Script waits for the other side of the
+// conversation before reporting a divergence.
+const DefaultScriptTimeout = time.Second
+
+// ErrDiverged is wrapped by all errors returned after a conversation diverged from its Script.
+var ErrDiverged = errors.New("grpcmock: conversation diverged")
+
+type stepKind int
+
+const (
+	stepSend stepKind = iota
+	stepCloseSend
+	stepRecv
+	stepEOF
+)
+
+type step struct {
+	kind stepKind
+	want interface{}
+	msg  interface{}
+	err  error
+}
+
+// isRecv indicates if the step is performed by the receiving side of the client.
+func (s step) isRecv() bool { return s.kind == stepRecv || s.kind == stepEOF }
+
+func (s step) String() string {
+	switch s.kind {
+	case stepSend:
+		return fmt.Sprintf("Send(%v)", s.want)
+	case stepCloseSend:
+		return "CloseSend()"
+	case stepEOF:
+		return "Recv() = EOF"
+	default:
+		if s.err != nil {
+			return fmt.Sprintf("Recv() = %v", s.err)
+		}
+		return fmt.Sprintf("Recv() = %v", s.msg)
+	}
+}
+
+// A Script is a scripted client stream of a bidirectional streaming method.
+// It implements the generated <Service>_<Method>Client interface and verifies
+// that the client under test follows the conversation in the given order:
+//
+//	s := NewRouteGuide_RouteChatClientScript().
+//		ExpectSend(EqRouteNote(a)).
+//		ThenRecv(b).
+//		ThenRecv(c).
+//		ExpectCloseSend().
+//		ThenEOF()
+//
+// Calls of the sending side (Send, CloseSend) block while the script waits for
+// the receiving side (Recv) and vice versa, so clients using separate goroutines
+// for sending and receiving are supported. If the other side does not continue
+// the conversation within the timeout, or a call does not match the next step,
+// the conversation diverged and all subsequent calls fail.
+type Script[Req, Res any] struct {
+	method  string
+	ctx     context.Context
+	timeout time.Duration
+
+	mu      sync.Mutex
+	changed chan struct{}
+	steps   []step
+	pos     int
+	last    *step
+	err     error
+}
+
+// NewScript creates a new, empty Script for the given full method name.
+func NewScript[Req, Res any](method string) *Script[Req, Res] {
+	return &Script[Req, Res]{
+		method:  method,
+		ctx:     context.Background(),
+		timeout: DefaultScriptTimeout,
+		changed: make(chan struct{}),
+	}
+}
+
+// WithContext sets the context returned by the Context method.
+func (s *Script[Req, Res]) WithContext(ctx context.Context) *Script[Req, Res] {
+	s.ctx = ctx
+	return s
+}
+
+// WithTimeout sets the time to wait for the other side of the conversation.
+func (s *Script[Req, Res]) WithTimeout(d time.Duration) *Script[Req, Res] {
+	s.timeout = d
+	return s
+}
+
+// ExpectSend expects the client to send a message matching m.
+// The argument may be a message, a Matcher or a plain value.
+func (s *Script[Req, Res]) ExpectSend(m interface{}) *Script[Req, Res] {
+	return s.add(step{kind: stepSend, want: m})
+}
+
+// ExpectCloseSend expects the client to close the sending side of the stream.
+func (s *Script[Req, Res]) ExpectCloseSend() *Script[Req, Res] {
+	return s.add(step{kind: stepCloseSend})
+}
+
+// ThenRecv lets the client receive the given message.
+func (s *Script[Req, Res]) ThenRecv(m *Res) *Script[Req, Res] {
+	return s.add(step{kind: stepRecv, msg: m})
+}
+
+// ThenError lets the client receive the given error, which ends the conversation.
+func (s *Script[Req, Res]) ThenError(err error) *Script[Req, Res] {
+	return s.add(step{kind: stepRecv, err: err})
+}
+
+// ThenEOF lets the client receive io.EOF, which ends the conversation.
+func (s *Script[Req, Res]) ThenEOF() *Script[Req, Res] {
+	return s.add(step{kind: stepEOF})
+}
+
+func (s *Script[Req, Res]) add(st step) *Script[Req, Res] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.steps = append(s.steps, st)
+	return s
+}
+
+// Send implements the Send method of the client stream.
+func (s *Script[Req, Res]) Send(m *Req) error {
+	_, err := s.do("Send", m, func(st step) bool { return st.kind == stepSend && Matches(st.want, m) })
+	return err
+}
+
+// CloseSend implements the CloseSend method of the client stream.
+func (s *Script[Req, Res]) CloseSend() error {
+	_, err := s.do("CloseSend", nil, func(st step) bool { return st.kind == stepCloseSend })
+	return err
+}
+
+// Recv implements the Recv method of the client stream.
+func (s *Script[Req, Res]) Recv() (*Res, error) {
+	st, err := s.do("Recv", nil, step.isRecv)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case st.kind == stepEOF:
+		return nil, io.EOF
+	case st.err != nil:
+		return nil, st.err
+	default:
+		return st.msg.(*Res), nil
+	}
+}
+
+// Header implements the grpc.ClientStream interface. It returns no metadata.
+func (s *Script[Req, Res]) Header() (metadata.MD, error) { return nil, nil }
+
+// Trailer implements the grpc.ClientStream interface. It returns no metadata.
+func (s *Script[Req, Res]) Trailer() metadata.MD { return nil }
+
+// Context implements the grpc.ClientStream interface.
+func (s *Script[Req, Res]) Context() context.Context { return s.ctx }
+
+// SendMsg implements the grpc.ClientStream interface.
+func (s *Script[Req, Res]) SendMsg(m interface{}) error {
+	req, ok := m.(*Req)
+	if !ok {
+		return fmt.Errorf("%w: %s: cannot send message of type %T", ErrDiverged, s.method, m)
+	}
+	return s.Send(req)
+}
+
+// RecvMsg implements the grpc.ClientStream interface.
+func (s *Script[Req, Res]) RecvMsg(m interface{}) error {
+	res, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	proto.Merge(m.(proto.Message), any(res).(proto.Message))
+	return nil
+}
+
+// AssertExpectations asserts that the conversation did not diverge and all
+// steps of the script were performed.
+func (s *Script[Req, Res]) AssertExpectations(t TestingT) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		t.Errorf("%v", s.err)
+		return false
+	}
+	if s.pos < len(s.steps) {
+		t.Errorf("grpcmock: %s: conversation stopped at step %d of %d:\n%s", s.method, s.pos+1, len(s.steps), s.conversation())
+		return false
+	}
+	return true
+}
+
+// do performs the operation op by waiting for and consuming the next step.
+// accept reports whether the next step matches the operation.
+func (s *Script[Req, Res]) do(op string, arg interface{}, accept func(step) bool) (step, error) {
+	call := op + "()"
+	if arg != nil {
+		call = fmt.Sprintf("%s(%v)", op, arg)
+	}
+	recv := op == "Recv"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for {
+		if s.err != nil {
+			return step{}, s.err
+		}
+		if s.pos == len(s.steps) {
+			// Receiving again after the end of the stream is allowed.
+			if recv && s.last != nil && s.last.isRecv() && (s.last.kind == stepEOF || s.last.err != nil) {
+				return *s.last, nil
+			}
+			return step{}, s.diverge("unexpected call after the end of the conversation: %s", call)
+		}
+
+		next := s.steps[s.pos]
+		if next.isRecv() == recv {
+			if !accept(next) {
+				return step{}, s.diverge("expected %s, got %s", next, call)
+			}
+			s.pos++
+			s.last = &next
+			close(s.changed)
+			s.changed = make(chan struct{})
+			return next, nil
+		}
+
+		// The next step belongs to the other side of the conversation.
+		if !s.wait(s.pos) {
+			if s.err != nil {
+				return step{}, s.err
+			}
+			if err := s.ctx.Err(); err != nil {
+				return step{}, err
+			}
+			return step{}, s.diverge("expected %s, got %s (timed out after %v)", next, call, s.timeout)
+		}
+	}
+}
+
+// wait waits until the conversation advanced beyond pos. It reports false if the
+// timeout expired or the context is done. The caller must hold the lock.
+func (s *Script[Req, Res]) wait(pos int) bool {
+	timer := time.NewTimer(s.timeout)
+	defer timer.Stop()
+
+	for s.pos == pos && s.err == nil {
+		changed := s.changed
+		s.mu.Unlock()
+		select {
+		case <-changed:
+			s.mu.Lock()
+		case <-timer.C:
+			s.mu.Lock()
+			return s.pos != pos
+		case <-s.ctx.Done():
+			s.mu.Lock()
+			return false
+		}
+	}
+	return true
+}
+
+// diverge records the divergence of the conversation and wakes up all waiting calls.
+// The caller must hold the lock.
+func (s *Script[Req, Res]) diverge(format string, args ...interface{}) error {
+	s.err = fmt.Errorf("%w: %s: step %d of %d: %s\n%s", ErrDiverged, s.method, s.pos+1, len(s.steps), fmt.Sprintf(format, args...), s.conversation())
+	close(s.changed)
+	s.changed = make(chan struct{})
+	return s.err
+}
+
+// conversation formats all steps and marks the current one.
+func (s *Script[Req, Res]) conversation() string {
+	var b strings.Builder
+	for i, st := range s.steps {
+		marker := "   "
+		switch {
+		case i < s.pos:
+			marker = " ok"
+		case i == s.pos:
+			marker = "-->"
+		}
+		fmt.Fprintf(&b, "%s %d. %s\n", marker, i+1, st)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,254 @@
+package grpcmock
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	// DefaultPageSize is the number of resources returned by Store.List, if no page size is given.
+	DefaultPageSize = 50
+	// MaxPageSize is the maximum number of resources returned by Store.List.
+	MaxPageSize = 1000
+)
+
+// A Store is an in-memory collection of resources, which are identified by their
+// `name` field as described in https://google.aip.dev/122. It implements the
+// behaviour of the AIP standard methods and is used by the generated fakes.
+//
+// All resources are copied when they are added to or returned from the Store.
+type Store[T proto.Message] struct {
+	mu     sync.Mutex
+	items  map[string]T
+	nextID int
+}
+
+// NewStore creates a new, empty Store.
+func NewStore[T proto.Message]() *Store[T] {
+	return &Store[T]{items: make(map[string]T)}
+}
+
+// NewID returns a new, unique resource ID.
+func (s *Store[T]) NewID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextID++
+	return strconv.Itoa(s.nextID)
+}
+
+// Put adds the resources to the Store. Existing resources are replaced.
+func (s *Store[T]) Put(resources ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, r := range resources {
+		s.items[resourceName(r)] = clone(r)
+	}
+}
+
+// Len returns the number of resources in the Store.
+func (s *Store[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.items)
+}
+
+// Get returns the resource with the given name. It fails with codes.NotFound,
+// if the resource does not exist.
+func (s *Store[T]) Get(name string) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.items[name]
+	if !ok {
+		return r, errNotFound(name)
+	}
+	return clone(r), nil
+}
+
+// List returns a page of the resources below parent, ordered by their name.
+// All resources are listed, if parent is empty. The returned token is empty
+// on the last page.
+func (s *Store[T]) List(parent string, pageSize int32, pageToken string) ([]T, string, error) {
+	switch {
+	case pageSize < 0:
+		return nil, "", status.Errorf(codes.InvalidArgument, "grpcmock: invalid page size %d", pageSize)
+	case pageSize == 0:
+		pageSize = DefaultPageSize
+	case pageSize > MaxPageSize:
+		pageSize = MaxPageSize
+	}
+
+	after, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, "", status.Errorf(codes.InvalidArgument, "grpcmock: invalid page token %q", pageToken)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := ""
+	if parent != "" {
+		prefix = parent + "/"
+	}
+	names := make([]string, 0, len(s.items))
+	for name := range s.items {
+		if strings.HasPrefix(name, prefix) && name > string(after) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	next := ""
+	if len(names) > int(pageSize) {
+		names = names[:pageSize]
+		next = base64.RawURLEncoding.EncodeToString([]byte(names[len(names)-1]))
+	}
+
+	resources := make([]T, len(names))
+	for i, name := range names {
+		resources[i] = clone(s.items[name])
+	}
+	return resources, next, nil
+}
+
+// Create adds a new resource. It fails with codes.AlreadyExists, if a resource
+// with the same name exists.
+func (s *Store[T]) Create(r T) (T, error) {
+	name := resourceName(r)
+	if name == "" {
+		var zero T
+		return zero, status.Error(codes.InvalidArgument, "grpcmock: the resource name is empty")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.items[name]; ok {
+		var zero T
+		return zero, status.Errorf(codes.AlreadyExists, "grpcmock: %q already exists", name)
+	}
+	s.items[name] = clone(r)
+	return clone(r), nil
+}
+
+// Update updates the fields of an existing resource given by the mask.
+// If the mask is empty, all populated fields of r are updated; the path `*`
+// replaces the whole resource. It fails with codes.NotFound, if the resource
+// does not exist, or codes.InvalidArgument, if the mask is invalid.
+func (s *Store[T]) Update(r T, mask *fieldmaskpb.FieldMask) (T, error) {
+	var zero T
+	name := resourceName(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	existing, ok := s.items[name]
+	if !ok {
+		return zero, errNotFound(name)
+	}
+
+	updated := clone(existing)
+	if err := applyFieldMask(updated.ProtoReflect(), clone(r).ProtoReflect(), mask.GetPaths()); err != nil {
+		return zero, err
+	}
+	s.items[name] = updated
+	return clone(updated), nil
+}
+
+// Delete removes the resource with the given name and returns it. It fails
+// with codes.NotFound, if the resource does not exist.
+func (s *Store[T]) Delete(name string) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r, ok := s.items[name]
+	if !ok {
+		return r, errNotFound(name)
+	}
+	delete(s.items, name)
+	return r, nil
+}
+
+// ResourceName joins the parent, the collection and the resource ID to a
+// resource name, for example `shelves/1/books/2`.
+func ResourceName(parent, collection, id string) string {
+	if parent == "" {
+		return collection + "/" + id
+	}
+	return parent + "/" + collection + "/" + id
+}
+
+func errNotFound(name string) error {
+	return status.Errorf(codes.NotFound, "grpcmock: %q not found", name)
+}
+
+func clone[T proto.Message](m T) T {
+	return proto.Clone(m).(T)
+}
+
+// resourceName returns the value of the `name` field of the resource.
+func resourceName(m proto.Message) string {
+	msg := m.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName("name")
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		panic("grpcmock: " + string(msg.Descriptor().FullName()) + " has no string field `name`")
+	}
+	return msg.Get(fd).String()
+}
+
+// applyFieldMask copies the fields given by paths from src to dst. The `name`
+// field is never changed.
+func applyFieldMask(dst, src protoreflect.Message, paths []string) error {
+	switch {
+	case len(paths) == 0:
+		// The implied mask contains all populated fields.
+		src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			if fd.Name() != "name" {
+				dst.Set(fd, v)
+			}
+			return true
+		})
+		return nil
+	case len(paths) == 1 && paths[0] == "*":
+		name := dst.Get(dst.Descriptor().Fields().ByName("name"))
+		proto.Reset(dst.Interface())
+		proto.Merge(dst.Interface(), src.Interface())
+		dst.Set(dst.Descriptor().Fields().ByName("name"), name)
+		return nil
+	}
+
+	for _, path := range paths {
+		if path == "name" {
+			continue
+		}
+		if err := applyPath(dst, src, strings.Split(path, ".")); err != nil {
+			return status.Errorf(codes.InvalidArgument, "grpcmock: invalid field mask path %q: %v", path, err)
+		}
+	}
+	return nil
+}
+
+func applyPath(dst, src protoreflect.Message, path []string) error {
+	fd := dst.Descriptor().Fields().ByName(protoreflect.Name(path[0]))
+	if fd == nil {
+		return fmt.Errorf("unknown field %q in %s", path[0], dst.Descriptor().FullName())
+	}
+	if len(path) == 1 {
+		if src.Has(fd) {
+			dst.Set(fd, src.Get(fd))
+		} else {
+			dst.Clear(fd)
+		}
+		return nil
+	}
+	if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+		return fmt.Errorf("field %q is not a message", path[0])
+	}
+	return applyPath(dst.Mutable(fd).Message(), src.Get(fd).Message(), path[1:])
+}
@@ -0,0 +1,68 @@
+package testifymock
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+)
+
+// Captor is an argument matcher, which matches any message of type *T and
+// captures the messages of the calls matching its expectation. The generated
+// Capture<Message> functions create captors for each message. The mocks accept
+// the captor in place of an argument of the generated On<Method> functions:
+//
+//	c := CapturePoint()
+//	m.OnGetFeature(mock.Anything, c).Return(feature, nil)
+//
+// The message is captured by the function set with Run of the expectation, so
+// calls, which match another expectation or none, are not captured. Setting
+// another function with Run replaces the capturing.
+type Captor[T any] struct {
+	grpcmock.Captor[T]
+}
+
+// NewCaptor creates a captor without any captured messages.
+func NewCaptor[T any]() *Captor[T] {
+	return &Captor[T]{}
+}
+
+// Matcher returns the testify argument matcher of the captor, which matches any
+// message of type *T without capturing it.
+func (c *Captor[T]) Matcher() interface{} {
+	return mock.MatchedBy(func(*T) bool { return true })
+}
+
+func (c *Captor[T]) capture(arg interface{}) {
+	v, _ := arg.(*T)
+	c.Record(v)
+}
+
+// capturer is implemented by captors to be converted to testify argument matchers.
+type capturer interface {
+	Matcher() interface{}
+	capture(arg interface{})
+}
+
+// On sets up an expectation of the method on the testify mock like mock.Mock.On
+// and records it in e for Called. It is used by the generated On and On<Method>
+// functions. The captors among the arguments are converted to argument matchers,
+// which capture the arguments of the calls matching the expectation.
+func On(m *mock.Mock, e *Expectations, method string, args ...interface{}) *mock.Call {
+	var captures []func(mock.Arguments)
+	for i, arg := range args {
+		if c, ok := arg.(capturer); ok {
+			i := i
+			args[i] = c.Matcher()
+			captures = append(captures, func(args mock.Arguments) { c.capture(args[i]) })
+		}
+	}
+	call := e.on(m, method, args)
+	if len(captures) > 0 {
+		call.Run(func(args mock.Arguments) {
+			for _, capture := range captures {
+				capture(args)
+			}
+		})
+	}
+	return call
+}
@@ -0,0 +1,152 @@
+package testifymock
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+)
+
+// testKey is the key of the test of a mock in its TestData.
+const testKey = "grpcmockTest"
+
+// Test sets the test of the testify mock like mock.Mock.Test. Unlike testify,
+// the failures of calls made by Called contain the diffs of the messages.
+func Test(m *mock.Mock, t mock.TestingT) {
+	m.Test(t)
+	m.TestData().Set(testKey, t)
+}
+
+// Called calls the method on the testify mock like mock.Mock.MethodCalled. It is
+// used by the generated mocks. If no expectation matches the call, the failure
+// contains a protocmp diff between the messages of the closest expectation and
+// the messages of the call. The failure is reported to the test set with Test or
+// panics otherwise.
+//
+// The call is matched against the expectations recorded in e by On, so the
+// expectations must not be set with mock.Mock.On directly.
+func Called(m *mock.Mock, e *Expectations, method string, args ...interface{}) mock.Arguments {
+	calls := e.call(method)
+	if e.match(calls, args) == nil {
+		fail(m, unexpectedCall(calls, method, args))
+		return nil
+	}
+	return m.MethodCalled(method, args...)
+}
+
+// fail reports the failure to the test set with Test or panics otherwise.
+func fail(m *mock.Mock, msg string) {
+	t, ok := m.TestData().Get(testKey).Data().(mock.TestingT)
+	if !ok {
+		panic(msg)
+	}
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	t.Errorf("%s", msg)
+	t.FailNow()
+}
+
+// unexpectedCall returns the failure of a call without a matching expectation.
+// It contains the diffs between the arguments and the closest of the
+// expectations of the method, which has the fewest mismatching arguments.
+func unexpectedCall(calls []*mock.Call, method string, args mock.Arguments) string {
+	var closest *mock.Call
+	var closestDiff string
+	best := 0
+	for _, call := range calls {
+		if diff, n := call.Arguments.Diff(args); closest == nil || n < best {
+			closest, closestDiff, best = call, diff, n
+		}
+	}
+	if closest == nil {
+		return fmt.Sprintf("mock: I don't know what to return because the method call was unexpected.\n\t%s(%s)\n\n"+
+			"Either do Mock.On(%q).Return(...) first, or remove the %s() call.", method, args.String(), method, method)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "mock: Unexpected Method Call\n\n%s(%s)\n\nThe closest call I have is:\n\n%s(%s)\n\n%s",
+		method, args.String(), method, closest.Arguments.String(), strings.Trim(closestDiff, "\n"))
+	for i, arg := range args {
+		if i >= len(closest.Arguments) {
+			break
+		}
+		got, ok := arg.(proto.Message)
+		if !ok {
+			continue
+		}
+		want, ok := expectedMessage(closest.Arguments[i])
+		if !ok || proto.Equal(want, got) {
+			continue
+		}
+		fmt.Fprintf(&b, "\n\nDiff of argument %d (-expected +actual):\n%s", i, cmp.Diff(want, got, protocmp.Transform()))
+	}
+	return b.String()
+}
+
+// messageMatcher is the argument matcher of EqMessage, which matches messages
+// equal to the expected message.
+type messageMatcher[T proto.Message] struct {
+	want T
+}
+
+func (m messageMatcher[T]) Matches(x messageArg) bool {
+	if p, ok := x.(*probe); ok {
+		p.want = m.want
+		return false
+	}
+	got, ok := x.(T)
+	if x != nil && !ok {
+		return false
+	}
+	return proto.Equal(m.want, got)
+}
+
+// messageArg is the parameter type of the matchers of EqMessage. It tells them
+// apart from the other matchers created with mock.MatchedBy, whose String
+// returns their parameter type.
+type messageArg interface {
+	proto.Message
+}
+
+// messageMatcherString is the String of the matchers of EqMessage.
+var messageMatcherString = fmt.Sprint(mock.MatchedBy(func(messageArg) bool { return false }))
+
+// probe is matched against the matchers of EqMessage by expectedMessage. The
+// matchers store their expected message in it.
+type probe struct {
+	want proto.Message
+}
+
+func (*probe) ProtoReflect() protoreflect.Message {
+	return nil
+}
+
+// EqMessage returns the testify argument matcher, which matches messages equal
+// to want. It is used by the generated Eq<Message> matchers, so the failures of
+// Called contain the diffs to the expected messages.
+func EqMessage[T proto.Message](want T) interface{} {
+	return mock.MatchedBy(messageMatcher[T]{want: want}.Matches)
+}
+
+// expectedMessage returns the message expected by the argument of an expectation,
+// which is either a message or a matcher created by EqMessage. Other matchers
+// are never called, as they may not accept the probe.
+func expectedMessage(arg interface{}) (proto.Message, bool) {
+	if msg, ok := arg.(proto.Message); ok {
+		return msg, true
+	}
+	m, ok := arg.(grpcmock.Matcher)
+	if !ok || fmt.Sprint(m) != messageMatcherString {
+		return nil, false
+	}
+	p := &probe{}
+	m.Matches(p)
+	return p.want, p.want != nil
+}
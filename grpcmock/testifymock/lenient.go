@@ -0,0 +1,102 @@
+package testifymock
+
+import (
+	"context"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+)
+
+// Unstubbed is the log of the calls of a lenient mock, which matched no
+// expectation and returned the results of their fallbacks. The mocks generated
+// with lenient=true embed it. The calls are not recorded in the Calls of the
+// testify mock, since they were not stubbed.
+type Unstubbed struct {
+	mu    sync.Mutex
+	calls []mock.Call
+}
+
+// UnstubbedCalls returns the calls, which matched no expectation, in the order
+// of the calls.
+func (u *Unstubbed) UnstubbedCalls() []mock.Call {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return append([]mock.Call(nil), u.calls...)
+}
+
+// AssertUnstubbedCalled asserts that the method was called with arguments
+// matching args without a matching expectation.
+func (u *Unstubbed) AssertUnstubbedCalled(t mock.TestingT, method string, args ...interface{}) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	calls := u.UnstubbedCalls()
+	for _, call := range calls {
+		if call.Method != method {
+			continue
+		}
+		if _, n := mock.Arguments(args).Diff(call.Arguments); n == 0 {
+			return true
+		}
+	}
+	actual := make([]string, len(calls))
+	for i, call := range calls {
+		actual[i] = "\t" + callString(call.Method, call.Arguments)
+	}
+	t.Errorf("grpcmock: the method was not called without an expectation: %s\n\tunstubbed calls:\n%s",
+		callString(method, args), strings.Join(actual, "\n"))
+	return false
+}
+
+func (u *Unstubbed) record(method string, args []interface{}) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.calls = append(u.calls, mock.Call{Method: method, Arguments: args})
+}
+
+// LenientCalled calls the method on the testify mock like Called. It is used by
+// the mocks generated with lenient=true: a call without a matching expectation
+// does not fail, but returns the results of fallback and is recorded in
+// unstubbed. Expectations, which match the call, but are limited with Once or
+// Times, still fail when they are called too often.
+func LenientCalled(m *mock.Mock, e *Expectations, unstubbed *Unstubbed, fallback func() []interface{}, method string, args ...interface{}) mock.Arguments {
+	if e.match(e.call(method), args) != nil {
+		return m.MethodCalled(method, args...)
+	}
+	unstubbed.record(method, args)
+	return fallback()
+}
+
+// Returns returns a fallback of LenientCalled, which returns the results.
+func Returns(results ...interface{}) func() []interface{} {
+	return func() []interface{} { return results }
+}
+
+// EOF returns a fallback of LenientCalled, which returns the results followed by io.EOF.
+func EOF(results ...interface{}) func() []interface{} {
+	return func() []interface{} { return append(results[:len(results):len(results)], io.EOF) }
+}
+
+// Unimplemented returns a fallback of LenientCalled, which returns the results
+// followed by an error with codes.Unimplemented for the full method name.
+func Unimplemented(method string, results ...interface{}) func() []interface{} {
+	return func() []interface{} {
+		return append(results[:len(results):len(results)], status.Errorf(codes.Unimplemented, "grpcmock: method %s is not stubbed", method))
+	}
+}
+
+// EndOfStream returns a fallback of LenientCalled for streaming client methods. It
+// returns a finished client stream of the method, which receives io.EOF, and no error.
+func EndOfStream[Req, Res any](ctx context.Context, method string) func() []interface{} {
+	return func() []interface{} {
+		client, server := grpcmock.NewPipe[Req, Res](ctx, method)
+		server.Finish(nil)
+		return []interface{}{client, nil}
+	}
+}
@@ -0,0 +1,140 @@
+package testifymock
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+)
+
+// Sequence is the order of expectations across testify mocks created by InOrder.
+type Sequence struct {
+	mu         sync.Mutex
+	calls      []*mock.Call
+	called     []int
+	actual     []string
+	violations []string
+	t          grpcmock.TestingT
+}
+
+// InOrder expects the calls to be made in the given order. The calls may belong
+// to different mocks, for example to a client mock and a stream mock:
+//
+//	seq := testifymock.InOrder(
+//		m.OnRecordRoute(mock.Anything),
+//		stream.OnSend(mock.Anything),
+//		stream.OnCloseAndRecv(),
+//	)
+//
+// A call is out of order, if a previous call of the sequence was not made yet or
+// a following call was already made. Violations panic like unexpected calls of
+// testify mocks, unless a test is set with Test. They are reported with the
+// actual sequence of the calls.
+//
+// InOrder wraps the functions set with Run, so it must be called after Run.
+func InOrder(calls ...*mock.Call) *Sequence {
+	s := &Sequence{calls: calls, called: make([]int, len(calls))}
+	for i, call := range calls {
+		i, run := i, call.RunFn
+		call.Run(func(args mock.Arguments) {
+			s.record(i, args)
+			if run != nil {
+				run(args)
+			}
+		})
+	}
+	return s
+}
+
+// Test sets the test, which reports violations of the order instead of panicking.
+func (s *Sequence) Test(t grpcmock.TestingT) *Sequence {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.t = t
+	return s
+}
+
+// AssertExpectations asserts that all calls of the sequence were made in order.
+func (s *Sequence) AssertExpectations(t grpcmock.TestingT) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ok := true
+	for _, v := range s.violations {
+		t.Errorf("%s", v)
+		ok = false
+	}
+	for i, n := range s.called {
+		if n == 0 {
+			t.Errorf("grpcmock: call %d of the sequence was not made: %s\n%s", i+1, callString(s.calls[i].Method, s.calls[i].Arguments), s.sequence())
+			ok = false
+		}
+	}
+	return ok
+}
+
+// record records the call i of the sequence and reports it, if it is out of order.
+func (s *Sequence) record(i int, args mock.Arguments) {
+	s.mu.Lock()
+	s.called[i]++
+	s.actual = append(s.actual, callString(s.calls[i].Method, args))
+
+	var violation string
+	for j, n := range s.called {
+		if (j < i && n == 0) || (j > i && n > 0) {
+			violation = fmt.Sprintf("grpcmock: call %d of the sequence was out of order: %s\n%s", i+1, s.actual[len(s.actual)-1], s.sequence())
+			s.violations = append(s.violations, violation)
+			break
+		}
+	}
+	t := s.t
+	s.mu.Unlock()
+
+	switch {
+	case violation == "":
+	case t != nil:
+		t.Errorf("%s", violation)
+	default:
+		panic(violation)
+	}
+}
+
+// sequence returns the expected and the actual sequence of the calls.
+func (s *Sequence) sequence() string {
+	var b strings.Builder
+	b.WriteString("\texpected sequence:\n")
+	for i, call := range s.calls {
+		fmt.Fprintf(&b, "\t\t%d: %s\n", i+1, callString(call.Method, call.Arguments))
+	}
+	b.WriteString("\tactual sequence:\n")
+	if len(s.actual) == 0 {
+		b.WriteString("\t\tno calls\n")
+	}
+	for i, call := range s.actual {
+		fmt.Fprintf(&b, "\t\t%d: %s\n", i+1, call)
+	}
+	return b.String()
+}
+
+func callString(method string, args mock.Arguments) string {
+	s := make([]string, len(args))
+	for i, arg := range args {
+		if m, ok := expectedMessage(arg); ok {
+			s[i] = fmt.Sprintf("%T{%s}", m, prototext.MarshalOptions{}.Format(m))
+			if _, ok := arg.(proto.Message); !ok {
+				s[i] = "Eq(" + s[i] + ")"
+			}
+		} else {
+			s[i] = fmt.Sprintf("%v", arg)
+		}
+	}
+	return method + "(" + strings.Join(s, ", ") + ")"
+}
@@ -0,0 +1,53 @@
+package testifymock
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+)
+
+// testifyMock is implemented by all mocks embedding testify's mock.Mock.
+type testifyMock interface {
+	AssertCalled(t mock.TestingT, methodName string, arguments ...interface{}) bool
+}
+
+// Received reports whether the testify mock received at least one call of the
+// method with arguments matching args and returns the failure reported by
+// testify otherwise. It is passed to gomegamock.HaveReceived by the generated
+// HaveReceived<Method> matchers.
+func Received(m interface{}, method string, args []interface{}) (bool, string, error) {
+	tm, ok := m.(testifyMock)
+	if !ok {
+		return false, "", fmt.Errorf("expected a testify mock, got %T", m)
+	}
+	testifyArgs := make([]interface{}, len(args))
+	for i, arg := range args {
+		testifyArgs[i] = testifyArgument(arg)
+	}
+	rec := &recorder{}
+	ok = tm.AssertCalled(rec, method, testifyArgs...)
+	return ok, rec.String(), nil
+}
+
+// testifyArgument converts messages and Matchers to testify argument matchers.
+func testifyArgument(arg interface{}) interface{} {
+	switch arg.(type) {
+	case proto.Message, grpcmock.Matcher:
+		return mock.MatchedBy(func(actual interface{}) bool { return grpcmock.Matches(arg, actual) })
+	default:
+		return arg
+	}
+}
+
+// recorder records the failures reported by testify.
+type recorder struct {
+	strings.Builder
+}
+
+func (r *recorder) Logf(format string, args ...interface{})   { fmt.Fprintf(r, format+"\n", args...) }
+func (r *recorder) Errorf(format string, args ...interface{}) { fmt.Fprintf(r, format+"\n", args...) }
+func (r *recorder) FailNow()                                  {}
@@ -0,0 +1,227 @@
+package testifymock
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/metadata"
+)
+
+// stream is the testify mock of a stream shared by the client and the
+// server streams. The calls report the diffs of the messages to the closest
+// expectation or return fallbacks, if the stream is lenient.
+type stream struct {
+	mock.Mock
+	Unstubbed
+	expectations Expectations
+	lenient      bool
+}
+
+// On sets up an expectation of the method like mock.Mock.On and records it
+// for the diffs of the calls.
+func (x *stream) On(method string, args ...interface{}) *mock.Call {
+	return On(&x.Mock, &x.expectations, method, args...)
+}
+
+// SetLenient sets whether calls without a matching expectation return fallbacks
+// like the other mocks generated with lenient=true instead of failing.
+func (x *stream) SetLenient(lenient bool) {
+	x.lenient = lenient
+}
+
+func (x *stream) called(fallback func() []interface{}, method string, args ...interface{}) mock.Arguments {
+	if x.lenient {
+		return LenientCalled(&x.Mock, &x.expectations, &x.Unstubbed, fallback, method, args...)
+	}
+	return Called(&x.Mock, &x.expectations, method, args...)
+}
+
+func (x *stream) Context() context.Context {
+	args := x.called(Returns(context.Background()), "Context")
+	return args.Get(0).(context.Context)
+}
+
+func (x *stream) RecvMsg(m interface{}) error {
+	args := x.called(EOF(), "RecvMsg", m)
+	return args.Error(0)
+}
+
+// clientStream implements the methods of grpc.ClientStream.
+type clientStream struct {
+	stream
+}
+
+func (x *clientStream) Header() (metadata.MD, error) {
+	args := x.called(Returns(metadata.MD(nil), nil), "Header")
+	return args.Get(0).(metadata.MD), args.Error(1)
+}
+
+func (x *clientStream) Trailer() metadata.MD {
+	args := x.called(Returns(metadata.MD(nil)), "Trailer")
+	return args.Get(0).(metadata.MD)
+}
+
+func (x *clientStream) CloseSend() error {
+	args := x.called(Returns(nil), "CloseSend")
+	return args.Error(0)
+}
+
+func (x *clientStream) SendMsg(m interface{}) error {
+	args := x.called(EOF(), "SendMsg", m)
+	return args.Error(0)
+}
+
+// send sends a request of a client stream.
+func (x *clientStream) send(m interface{}) error {
+	args := x.called(EOF(), "Send", m)
+	return args.Error(0)
+}
+
+// serverStream implements the methods of grpc.ServerStream.
+type serverStream struct {
+	stream
+}
+
+func (x *serverStream) SetHeader(md metadata.MD) error {
+	args := x.called(Returns(nil), "SetHeader", md)
+	return args.Error(0)
+}
+
+func (x *serverStream) SendHeader(md metadata.MD) error {
+	args := x.called(Returns(nil), "SendHeader", md)
+	return args.Error(0)
+}
+
+func (x *serverStream) SetTrailer(md metadata.MD) {
+	_ = x.called(Returns(), "SetTrailer", md)
+}
+
+func (x *serverStream) SendMsg(m interface{}) error {
+	args := x.called(Returns(nil), "SendMsg", m)
+	return args.Error(0)
+}
+
+// recv receives a message of the stream with the method.
+func recv[T any](x *stream, method string) (*T, error) {
+	args := x.called(EOF((*T)(nil)), method)
+	return args.Get(0).(*T), args.Error(1)
+}
+
+// ServerStreamingClient is the testify mock of the client stream of a
+// server streaming method. The generated mocks of the client streams embed it.
+type ServerStreamingClient[Res any] struct {
+	clientStream
+}
+
+func (x *ServerStreamingClient[Res]) Recv() (*Res, error) {
+	return recv[Res](&x.stream, "Recv")
+}
+
+func (x *ServerStreamingClient[Res]) OnRecv() *mock.Call {
+	return x.On("Recv")
+}
+
+// ClientStreamingClient is the testify mock of the client stream of a
+// client streaming method. The generated mocks of the client streams embed it.
+type ClientStreamingClient[Req, Res any] struct {
+	clientStream
+}
+
+func (x *ClientStreamingClient[Req, Res]) Send(m *Req) error {
+	return x.send(m)
+}
+
+func (x *ClientStreamingClient[Req, Res]) OnSend(m interface{}) *mock.Call {
+	return x.On("Send", m)
+}
+
+func (x *ClientStreamingClient[Req, Res]) CloseAndRecv() (*Res, error) {
+	return recv[Res](&x.stream, "CloseAndRecv")
+}
+
+func (x *ClientStreamingClient[Req, Res]) OnCloseAndRecv() *mock.Call {
+	return x.On("CloseAndRecv")
+}
+
+// BidiStreamingClient is the testify mock of the client stream of a
+// bidirectional streaming method. The generated mocks of the client streams embed it.
+type BidiStreamingClient[Req, Res any] struct {
+	clientStream
+}
+
+func (x *BidiStreamingClient[Req, Res]) Send(m *Req) error {
+	return x.send(m)
+}
+
+func (x *BidiStreamingClient[Req, Res]) OnSend(m interface{}) *mock.Call {
+	return x.On("Send", m)
+}
+
+func (x *BidiStreamingClient[Req, Res]) Recv() (*Res, error) {
+	return recv[Res](&x.stream, "Recv")
+}
+
+func (x *BidiStreamingClient[Req, Res]) OnRecv() *mock.Call {
+	return x.On("Recv")
+}
+
+// ServerStreamingServer is the testify mock of the server stream of a
+// server streaming method. The generated mocks of the server streams embed it.
+type ServerStreamingServer[Res any] struct {
+	serverStream
+}
+
+func (x *ServerStreamingServer[Res]) Send(m *Res) error {
+	args := x.called(Returns(nil), "Send", m)
+	return args.Error(0)
+}
+
+func (x *ServerStreamingServer[Res]) OnSend(m interface{}) *mock.Call {
+	return x.On("Send", m)
+}
+
+// ClientStreamingServer is the testify mock of the server stream of a
+// client streaming method. The generated mocks of the server streams embed it.
+type ClientStreamingServer[Req, Res any] struct {
+	serverStream
+}
+
+func (x *ClientStreamingServer[Req, Res]) Recv() (*Req, error) {
+	return recv[Req](&x.stream, "Recv")
+}
+
+func (x *ClientStreamingServer[Req, Res]) OnRecv() *mock.Call {
+	return x.On("Recv")
+}
+
+func (x *ClientStreamingServer[Req, Res]) SendAndClose(m *Res) error {
+	args := x.called(Returns(nil), "SendAndClose", m)
+	return args.Error(0)
+}
+
+func (x *ClientStreamingServer[Req, Res]) OnSendAndClose(m interface{}) *mock.Call {
+	return x.On("SendAndClose", m)
+}
+
+// BidiStreamingServer is the testify mock of the server stream of a
+// bidirectional streaming method. The generated mocks of the server streams embed it.
+type BidiStreamingServer[Req, Res any] struct {
+	serverStream
+}
+
+func (x *BidiStreamingServer[Req, Res]) Recv() (*Req, error) {
+	return recv[Req](&x.stream, "Recv")
+}
+
+func (x *BidiStreamingServer[Req, Res]) OnRecv() *mock.Call {
+	return x.On("Recv")
+}
+
+func (x *BidiStreamingServer[Req, Res]) Send(m *Res) error {
+	args := x.called(Returns(nil), "Send", m)
+	return args.Error(0)
+}
+
+func (x *BidiStreamingServer[Req, Res]) OnSend(m interface{}) *mock.Call {
+	return x.On("Send", m)
+}
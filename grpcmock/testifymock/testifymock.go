@@ -0,0 +1,106 @@
+// Package testifymock contains the runtime support for the mocks generated by
+// protoc-gen-go-grpcmock with framework=testify.
+package testifymock
+
+import (
+	"sync"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/grpcmock"
+)
+
+// tHelper is implemented by testing.TB to mark helper functions.
+type tHelper interface {
+	Helper()
+}
+
+// TrackCoverage registers the expectations of a testify mock of the service with
+// the methods for the coverage report of grpcmock.RunWithCoverage. It is called
+// by the constructors of the generated mocks.
+func TrackCoverage(service string, methods []string, e *Expectations) {
+	grpcmock.TrackCoverage(service, methods, e)
+}
+
+// Expectations records the expectations set with On and counts the calls of a
+// testify mock. testify does not expose the expectations of a mock without a
+// data race, so Called and LenientCalled match the calls against the recorded
+// expectations instead. The generated mocks record the expectations set with
+// their On and On<Method> functions.
+type Expectations struct {
+	mu      sync.Mutex
+	calls   []*mock.Call
+	matched map[*mock.Call]int
+	called  map[string]int
+}
+
+// on sets up the expectation of the method on the mock and records it.
+func (e *Expectations) on(m *mock.Mock, method string, args []interface{}) *mock.Call {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	call := m.On(method, args...)
+	e.calls = append(e.calls, call)
+	return call
+}
+
+// call counts a call of the method and returns the expectations of the method
+// in the order they were set. The method and the arguments of the expectations
+// are not changed by testify after they are set, so they can be read without
+// the lock of the mock.
+func (e *Expectations) call(method string) []*mock.Call {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.called == nil {
+		e.called = make(map[string]int)
+	}
+	e.called[method]++
+	var calls []*mock.Call
+	for _, call := range e.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// match returns the first of the expectations of a method, whose arguments
+// match the arguments of the call, or nil, and counts the call for all matching
+// expectations. Like testify, the expectation may be exhausted by its
+// repeatability, which is checked by mock.Mock.MethodCalled.
+func (e *Expectations) match(calls []*mock.Call, args []interface{}) *mock.Call {
+	var matching []*mock.Call
+	for _, call := range calls {
+		if _, n := call.Arguments.Diff(args); n == 0 {
+			matching = append(matching, call)
+		}
+	}
+	if len(matching) == 0 {
+		return nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.matched == nil {
+		e.matched = make(map[*mock.Call]int)
+	}
+	for _, call := range matching {
+		e.matched[call]++
+	}
+	return matching[0]
+}
+
+// CountCalls implements grpcmock.CallCounter. The expectations, which matched
+// no call, are reported as unmatched stubs.
+func (e *Expectations) CountCalls(stubbed, called map[string]int, unmatched map[string][]string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, call := range e.calls {
+		stubbed[call.Method]++
+		if e.matched[call] == 0 {
+			unmatched[call.Method] = append(unmatched[call.Method], callString(call.Method, call.Arguments))
+		}
+	}
+	for method, n := range e.called {
+		called[method] += n
+	}
+}
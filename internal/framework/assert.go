@@ -0,0 +1,52 @@
+package framework
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// grpcIdent returns the identifier of a type generated by protoc-gen-go-grpc.
+// The types are located in the original Go package of the file, even if
+// the mocks are generated in a different package.
+func grpcIdent(file *protogen.File, name string) protogen.GoIdent {
+	return file.GoDescriptorIdent.GoImportPath.Ident(name)
+}
+
+// unimplementedServer returns the unimplemented server generated by
+// protoc-gen-go-grpc for the service. The server mocks embed it, since the
+// server interface requires it by default to be registered with a grpc.Server.
+// The mocks implement all methods of the service themselves, so only the
+// unexported method required by the interface is promoted from it.
+func unimplementedServer(file *protogen.File, service *protogen.Service) protogen.GoIdent {
+	return grpcIdent(file, "Unimplemented"+service.GoName+ServerSuffix)
+}
+
+// generateAssertions generates compile-time assertions, that the mocks with the
+// prefix, scripts, pipes and intercepted streams of the service implement the
+// interfaces generated by protoc-gen-go-grpc.
+func generateAssertions(g *protogen.GeneratedFile, file *protogen.File, service *protogen.Service, prefix string) {
+	g.P("var (")
+	g.P("_ ", grpcIdent(file, service.GoName+ClientSuffix), " = (*", prefix, service.GoName, ClientSuffix, ")(nil)")
+	g.P("_ ", grpcIdent(file, service.GoName+ServerSuffix), " = (*", prefix, service.GoName, ServerSuffix, ")(nil)")
+	for _, method := range service.Methods {
+		if !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer() {
+			continue
+		}
+
+		streamName := service.GoName + "_" + method.GoName
+		typeArgs := "[" + g.QualifiedGoIdent(method.Input.GoIdent) + ", " + g.QualifiedGoIdent(method.Output.GoIdent) + "]"
+		clientStream := grpcIdent(file, streamName+ClientSuffix)
+		serverStream := grpcIdent(file, streamName+ServerSuffix)
+
+		g.P("_ ", clientStream, " = (*", prefix, streamName, ClientSuffix, ")(nil)")
+		g.P("_ ", serverStream, " = (*", prefix, streamName, ServerSuffix, ")(nil)")
+		g.P("_ ", clientStream, " = (*", grpcmockPackage.Ident("ClientStream"), typeArgs, ")(nil)")
+		g.P("_ ", serverStream, " = (*", grpcmockPackage.Ident("ServerStream"), typeArgs, ")(nil)")
+		g.P("_ ", clientStream, " = (*", grpcmockPackage.Ident("InterceptedClientStream"), typeArgs, ")(nil)")
+		g.P("_ ", serverStream, " = (*", grpcmockPackage.Ident("InterceptedServerStream"), typeArgs, ")(nil)")
+		if method.Desc.IsStreamingClient() && method.Desc.IsStreamingServer() {
+			g.P("_ ", clientStream, " = (*", grpcmockPackage.Ident("Script"), typeArgs, ")(nil)")
+		}
+	}
+	g.P(")")
+	g.P()
+}
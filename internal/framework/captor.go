@@ -0,0 +1,15 @@
+package framework
+
+import "google.golang.org/protobuf/compiler/protogen"
+
+// generateCaptors generates a constructor of a typed captor for all messages of
+// the file. The captors are the matchers of the framework package pkg.
+func generateCaptors(g *protogen.GeneratedFile, file *protogen.File, pkg protogen.GoImportPath) {
+	for _, msg := range file.Messages {
+		captor := g.QualifiedGoIdent(pkg.Ident("Captor")) + "[" + g.QualifiedGoIdent(msg.GoIdent) + "]"
+		g.P("func Capture", msg.GoIdent.GoName, "() *", captor, " {")
+		g.P("return ", pkg.Ident("NewCaptor"), "[", msg.GoIdent, "]()")
+		g.P("}")
+		g.P()
+	}
+}
@@ -0,0 +1,299 @@
+package framework
+
+import (
+	"path"
+	"path/filepath"
+	"strconv"
+
+	"github.com/petergtz/pegomock/mockgen"
+	"github.com/petergtz/pegomock/model"
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/internal/generator"
+	mockmodel "github.com/lovoo/protoc-gen-go-grpcmock/internal/model"
+)
+
+const (
+	connectPackage = protogen.GoImportPath("connectrpc.com/connect")
+
+	// ConnectPackageSuffix is the suffix of the Go packages generated by protoc-gen-connect-go.
+	ConnectPackageSuffix = "connect"
+	// ConnectFilenameSuffix is the suffix of the files containing the connect mocks.
+	ConnectFilenameSuffix = ".connect_mock.go"
+)
+
+// connectOutput returns the output of the connect mocks, which are generated to
+// the package of the code generated by protoc-gen-connect-go.
+func connectOutput(file *protogen.File) generator.Output {
+	// The mocks are always generated to the connect package, even if the import of
+	// the file's package is forced, so the original import path is used.
+	pkg := protogen.GoPackageName(string(file.GoPackageName) + ConnectPackageSuffix)
+	prefix := filepath.ToSlash(file.GeneratedFilenamePrefix)
+	return generator.Output{
+		Filename:    path.Join(path.Dir(prefix), string(pkg), path.Base(prefix)+ConnectFilenameSuffix),
+		PackageName: pkg,
+		ImportPath:  protogen.GoImportPath(path.Join(string(file.GoDescriptorIdent.GoImportPath), string(pkg))),
+	}
+}
+
+// connectRequests returns the messages of the file, which are sent as
+// connect.Request by the unary and server streaming methods.
+func connectRequests(file *protogen.File) []*protogen.Message {
+	var msgs []*protogen.Message
+	seen := make(map[*protogen.Message]bool)
+	for _, service := range file.Services {
+		for _, method := range service.Methods {
+			if method.Desc.IsStreamingClient() || seen[method.Input] || method.Input.Desc.ParentFile() != file.Desc {
+				continue
+			}
+			seen[method.Input] = true
+			msgs = append(msgs, method.Input)
+		}
+	}
+	return msgs
+}
+
+// connectType returns the generic type of the connect package instantiated with the type arguments.
+func connectType(g *protogen.GeneratedFile, name string, args ...string) string {
+	s := "*" + g.QualifiedGoIdent(connectPackage.Ident(name)) + "["
+	for i, arg := range args {
+		if i > 0 {
+			s += ", "
+		}
+		s += arg
+	}
+	return s + "]"
+}
+
+// generateConnectAssertions generates compile-time assertions, that the connect
+// mocks implement the interfaces generated by protoc-gen-connect-go.
+func generateConnectAssertions(g *protogen.GeneratedFile, service *protogen.Service) {
+	g.P("var (")
+	g.P("_ ", service.GoName, ClientSuffix, " = (*", MockPrefix, service.GoName, ClientSuffix, ")(nil)")
+	g.P("_ ", service.GoName, HandlerSuffix, " = (*", MockPrefix, service.GoName, HandlerSuffix, ")(nil)")
+	g.P(")")
+	g.P()
+}
+
+func (tm *testifyMocker) mockConnect(g *protogen.GeneratedFile, file *protogen.File) {
+	for _, msg := range connectRequests(file) {
+		request := connectType(g, "Request", g.QualifiedGoIdent(msg.GoIdent))
+
+		g.P("func AnyRequest", msg.GoIdent.GoName, "() interface{} {")
+		g.P("return ", testifyMockPackage.Ident("MatchedBy"), "(func(", request, ") bool { return true })")
+		g.P("}")
+		g.P()
+
+		g.P("func EqRequest", msg.GoIdent.GoName, "(v *", msg.GoIdent, ") interface{} {")
+		g.P("return ", testifyMockPackage.Ident("MatchedBy"), "(func(x ", request, ") bool {")
+		g.P("return x != nil && ", protoPackage.Ident("Equal"), "(v, x.Msg)")
+		g.P("})")
+		g.P("}")
+		g.P()
+	}
+
+	output := connectOutput(file)
+	for _, service := range file.Services {
+		clientName := MockPrefix + service.GoName + ClientSuffix
+		tm.generateStruct(g, service, clientName)
+		tm.generateNewFunc(g, service, clientName, true, tm.lenientService(service))
+		for _, method := range service.Methods {
+			tm.generateMethodDefinitions(g, tm.connectClientMethod(g, method))
+		}
+
+		// The handler embeds the unimplemented handler like the gRPC server mock.
+		handlerName := MockPrefix + service.GoName + HandlerSuffix
+		tm.generateStruct(g, service, handlerName, output.ImportPath.Ident("Unimplemented"+service.GoName+HandlerSuffix))
+		tm.generateNewFunc(g, service, handlerName, true, tm.lenientService(service))
+		for _, method := range service.Methods {
+			tm.generateMethodDefinitions(g, tm.connectHandlerMethod(g, method))
+		}
+
+		generateConnectAssertions(g, service)
+	}
+}
+
+func (tm *testifyMocker) connectClientMethod(g *protogen.GeneratedFile, method *protogen.Method) *mockmodel.Method {
+	m := mockmodel.NewMethod(method, mockmodel.Receiver{Name: "c", Type: "*" + MockPrefix + method.Parent.GoName + ClientSuffix})
+	in, out := g.QualifiedGoIdent(method.Input.GoIdent), g.QualifiedGoIdent(method.Output.GoIdent)
+	m.AddArgument("ctx", g.QualifiedGoIdent(contextPackage.Ident("Context")))
+	switch {
+	case method.Desc.IsStreamingClient() && method.Desc.IsStreamingServer():
+		m.AddReturn(connectType(g, "BidiStreamForClient", in, out))
+	case method.Desc.IsStreamingClient():
+		m.AddReturn(connectType(g, "ClientStreamForClient", in, out))
+	case method.Desc.IsStreamingServer():
+		m.AddArgument("req", connectType(g, "Request", in))
+		m.AddReturn(connectType(g, "ServerStreamForClient", out))
+		m.AddReturn("error")
+	default:
+		m.AddArgument("req", connectType(g, "Request", in))
+		m.AddReturn(connectType(g, "Response", out))
+		m.AddReturn("error")
+	}
+	return m
+}
+
+func (tm *testifyMocker) connectHandlerMethod(g *protogen.GeneratedFile, method *protogen.Method) *mockmodel.Method {
+	m := mockmodel.NewMethod(method, mockmodel.Receiver{Name: "h", Type: "*" + MockPrefix + method.Parent.GoName + HandlerSuffix})
+	in, out := g.QualifiedGoIdent(method.Input.GoIdent), g.QualifiedGoIdent(method.Output.GoIdent)
+	m.AddArgument("ctx", g.QualifiedGoIdent(contextPackage.Ident("Context")))
+	switch {
+	case method.Desc.IsStreamingClient() && method.Desc.IsStreamingServer():
+		m.AddArgument("stream", connectType(g, "BidiStream", in, out))
+	case method.Desc.IsStreamingClient():
+		m.AddArgument("stream", connectType(g, "ClientStream", in))
+		m.AddReturn(connectType(g, "Response", out))
+	case method.Desc.IsStreamingServer():
+		m.AddArgument("req", connectType(g, "Request", in))
+		m.AddArgument("stream", connectType(g, "ServerStream", out))
+	default:
+		m.AddArgument("req", connectType(g, "Request", in))
+		m.AddReturn(connectType(g, "Response", out))
+	}
+	m.AddReturn("error")
+	return m
+}
+
+// pegomockTypeArgs qualifies the type arguments of the generic connect types.
+// Pegomock does not support generic types, so they are passed as named types,
+// whose packages are resolved by aliases, when the output is generated.
+type pegomockTypeArgs struct {
+	self    protogen.GoImportPath
+	aliases map[protogen.GoImportPath]string
+}
+
+func (a *pegomockTypeArgs) ident(ident protogen.GoIdent) string {
+	if ident.GoImportPath == a.self {
+		return ident.GoName
+	}
+	alias, ok := a.aliases[ident.GoImportPath]
+	if !ok {
+		alias = "grpcmock_typearg" + strconv.Itoa(len(a.aliases))
+		a.aliases[ident.GoImportPath] = alias
+	}
+	return alias + "." + ident.GoName
+}
+
+func (a *pegomockTypeArgs) connectType(name string, args ...protogen.GoIdent) model.Type {
+	typ := name + "["
+	for i, arg := range args {
+		if i > 0 {
+			typ += ", "
+		}
+		typ += a.ident(arg)
+	}
+	return &model.PointerType{Type: &model.NamedType{Package: string(connectPackage), Type: typ + "]"}}
+}
+
+func (pm *pegomockMocker) mockConnect(g *protogen.GeneratedFile, file *protogen.File) {
+	output := connectOutput(file)
+	typeArgs := &pegomockTypeArgs{self: output.ImportPath, aliases: make(map[protogen.GoImportPath]string)}
+
+	for _, service := range file.Services {
+		interfaces := []*model.Interface{
+			{
+				Name:    service.GoName + ClientSuffix, // Pegomock automatically adds the `Mock` prefix
+				Methods: mapSlice(service.Methods, func(method *protogen.Method) *model.Method { return pm.connectClientMethod(typeArgs, method) }),
+			},
+			{
+				Name:    service.GoName + HandlerSuffix, // Pegomock automatically adds the `Mock` prefix
+				Methods: mapSlice(service.Methods, func(method *protogen.Method) *model.Method { return pm.connectHandlerMethod(typeArgs, method) }),
+			},
+		}
+
+		// The matchers of the generic types cannot be generated by pegomock,
+		// so the request matchers are generated below instead.
+		data, _ := mockgen.GenerateOutput(&model.Package{Name: string(output.PackageName), Interfaces: interfaces},
+			file.Desc.Path(), "", string(output.PackageName), string(output.ImportPath))
+
+		src := mustParseGoSource(data)
+		for importPath, alias := range typeArgs.aliases {
+			src.alias(alias, importPath)
+		}
+
+		// The handler mock embeds the unimplemented handler to satisfy the handler interface.
+		if st := src.structType(MockPrefix + service.GoName + HandlerSuffix); st != nil {
+			src.insert(st.Fields.Opening+1, "\nUnimplemented"+service.GoName+HandlerSuffix)
+		}
+		pm.withCoverage(g, src, service, service.GoName+ClientSuffix, service.GoName+HandlerSuffix)
+		src.generate(g)
+		if pm.opts.TestingTB {
+			pm.generateTestingTB(g, interfaces)
+		}
+
+		generateConnectAssertions(g, service)
+	}
+
+	for _, msg := range connectRequests(file) {
+		request := connectType(g, "Request", g.QualifiedGoIdent(msg.GoIdent))
+
+		g.P("func AnyRequest", msg.GoIdent.GoName, "() ", request, " {")
+		g.P(pegomockPackage.Ident("RegisterMatcher"), "(", pegomockPackage.Ident("NewAnyMatcher"), "(",
+			reflectPackage.Ident("TypeOf"), "((*", request, ")(nil)).Elem()))")
+		g.P("return nil")
+		g.P("}")
+		g.P()
+
+		g.P("func EqRequest", msg.GoIdent.GoName, "(v *", msg.GoIdent, ") ", request, " {")
+		g.P("return ", pegomockmockPackage.Ident("ArgThat"), "(func(x ", request, ") bool {")
+		g.P("return x != nil && ", protoPackage.Ident("Equal"), "(v, x.Msg)")
+		g.P("})")
+		g.P("}")
+		g.P()
+	}
+}
+
+func (pm *pegomockMocker) connectClientMethod(typeArgs *pegomockTypeArgs, method *protogen.Method) *model.Method {
+	m := &model.Method{
+		Name: method.GoName,
+		In: []*model.Parameter{
+			{Name: "ctx", Type: pm.qualifiedGoIdent(contextPackage.Ident("Context"))},
+		},
+	}
+	switch {
+	case method.Desc.IsStreamingClient() && method.Desc.IsStreamingServer():
+		m.Out = []*model.Parameter{{Type: typeArgs.connectType("BidiStreamForClient", method.Input.GoIdent, method.Output.GoIdent)}}
+	case method.Desc.IsStreamingClient():
+		m.Out = []*model.Parameter{{Type: typeArgs.connectType("ClientStreamForClient", method.Input.GoIdent, method.Output.GoIdent)}}
+	case method.Desc.IsStreamingServer():
+		m.In = append(m.In, &model.Parameter{Name: "req", Type: typeArgs.connectType("Request", method.Input.GoIdent)})
+		m.Out = []*model.Parameter{
+			{Type: typeArgs.connectType("ServerStreamForClient", method.Output.GoIdent)},
+			{Type: model.PredeclaredType("error")},
+		}
+	default:
+		m.In = append(m.In, &model.Parameter{Name: "req", Type: typeArgs.connectType("Request", method.Input.GoIdent)})
+		m.Out = []*model.Parameter{
+			{Type: typeArgs.connectType("Response", method.Output.GoIdent)},
+			{Type: model.PredeclaredType("error")},
+		}
+	}
+	return m
+}
+
+func (pm *pegomockMocker) connectHandlerMethod(typeArgs *pegomockTypeArgs, method *protogen.Method) *model.Method {
+	m := &model.Method{
+		Name: method.GoName,
+		In: []*model.Parameter{
+			{Name: "ctx", Type: pm.qualifiedGoIdent(contextPackage.Ident("Context"))},
+		},
+	}
+	switch {
+	case method.Desc.IsStreamingClient() && method.Desc.IsStreamingServer():
+		m.In = append(m.In, &model.Parameter{Name: "stream", Type: typeArgs.connectType("BidiStream", method.Input.GoIdent, method.Output.GoIdent)})
+	case method.Desc.IsStreamingClient():
+		m.In = append(m.In, &model.Parameter{Name: "stream", Type: typeArgs.connectType("ClientStream", method.Input.GoIdent)})
+		m.Out = append(m.Out, &model.Parameter{Type: typeArgs.connectType("Response", method.Output.GoIdent)})
+	case method.Desc.IsStreamingServer():
+		m.In = append(m.In,
+			&model.Parameter{Name: "req", Type: typeArgs.connectType("Request", method.Input.GoIdent)},
+			&model.Parameter{Name: "stream", Type: typeArgs.connectType("ServerStream", method.Output.GoIdent)},
+		)
+	default:
+		m.In = append(m.In, &model.Parameter{Name: "req", Type: typeArgs.connectType("Request", method.Input.GoIdent)})
+		m.Out = append(m.Out, &model.Parameter{Type: typeArgs.connectType("Response", method.Output.GoIdent)})
+	}
+	m.Out = append(m.Out, &model.Parameter{Type: model.PredeclaredType("error")})
+	return m
+}
@@ -0,0 +1,87 @@
+package framework
+
+import (
+	"go/ast"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// trackCoverage returns the call of TrackCoverage of the framework package pkg,
+// which registers the mock of the service for the coverage report of
+// grpcmock.RunWithCoverage.
+func trackCoverage(g *protogen.GeneratedFile, pkg protogen.GoImportPath, service *protogen.Service, mock string) string {
+	methods := mapSlice(service.Methods, func(method *protogen.Method) string { return strconv.Quote(method.GoName) })
+	return g.QualifiedGoIdent(pkg.Ident("TrackCoverage")) + "(" + strconv.Quote(string(service.Desc.FullName())) +
+		", []string{" + strings.Join(methods, ", ") + "}, " + mock + ")"
+}
+
+// withCoverage changes the pegomock mocks of the service to record their
+// invocations and their constructors to register the mocks for the coverage
+// report.
+func (pm *pegomockMocker) withCoverage(g *protogen.GeneratedFile, output *goSource, service *protogen.Service, names ...string) {
+	for _, name := range names {
+		typeName := MockPrefix + name
+		ctor := output.funcDecl("New" + typeName)
+		st := output.structType(typeName)
+		if ctor == nil || len(ctor.Body.List) == 0 || st == nil {
+			continue
+		}
+		output.insert(st.Fields.Closing, "invocations "+g.QualifiedGoIdent(pegomockmockPackage.Ident("Invocations"))+"\n")
+		output.insert(ctor.Body.List[0].End(), "\n"+trackCoverage(g, pegomockmockPackage, service, "mock, &mock.invocations"))
+
+		// The invocations are recorded before pegomock invokes the method,
+		// which panics, if it is stubbed with ThenPanic. The verifiers forget
+		// the matchers recorded for the verification.
+		for _, decl := range output.file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			switch {
+			case isMethodOf(fn, typeName):
+				insertBefore(output, fn, "Invoke", "mock.invocations.Invoked("+strconv.Quote(fn.Name.Name)+", params)\n")
+			case isMethodOf(fn, "Verifier"+typeName):
+				insertBefore(output, fn, "Verify", g.QualifiedGoIdent(pegomockmockPackage.Ident("ResetMatchers"))+"()\n")
+			}
+		}
+	}
+}
+
+// insertBefore inserts the text before the first statement of the function,
+// which calls a method with the name.
+func insertBefore(output *goSource, fn *ast.FuncDecl, name, text string) {
+	for _, stmt := range fn.Body.List {
+		if calls(stmt, name) {
+			output.insert(stmt.Pos(), text)
+			return
+		}
+	}
+}
+
+// isMethodOf reports whether the function is a method of the type with a pointer receiver.
+func isMethodOf(fn *ast.FuncDecl, typeName string) bool {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 {
+		return false
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == typeName
+}
+
+// calls reports whether the statement calls a method with the name, like Invoke
+// or Verify on the generic mock of pegomock.
+func calls(stmt ast.Stmt, name string) bool {
+	found := false
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok && sel.Sel.Name == name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
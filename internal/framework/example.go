@@ -0,0 +1,181 @@
+package framework
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+var (
+	fmtPackage = protogen.GoImportPath("fmt")
+	ioPackage  = protogen.GoImportPath("io")
+)
+
+// example describes the example of a method of the client mock.
+type example struct {
+	method *protogen.Method
+	// prefix is the prefix of the names of the mocks.
+	prefix string
+	// mock and stream are the expressions creating the client mock and the stream mock.
+	mock, stream string
+	// in and out are the qualified types of the request and the response.
+	in, out string
+}
+
+// newExample returns the example of the method for the mocks with the prefix.
+func newExample(g *protogen.GeneratedFile, method *protogen.Method, prefix string) *example {
+	client := prefix + method.Parent.GoName + ClientSuffix
+	stream := prefix + method.Parent.GoName + "_" + method.GoName + ClientSuffix
+	e := &example{
+		method: method,
+		prefix: prefix,
+		mock:   "New" + client + "()",
+		stream: "New" + stream + "()",
+		in:     g.QualifiedGoIdent(method.Input.GoIdent),
+		out:    g.QualifiedGoIdent(method.Output.GoIdent),
+	}
+	return e
+}
+
+// open generates the signature of the example and the creation of the mocks.
+func (e *example) open(g *protogen.GeneratedFile) {
+	g.P("func Example", e.prefix, e.method.Parent.GoName, ClientSuffix, "_", e.method.GoName, "() {")
+	g.P("m := ", e.mock)
+	if e.method.Desc.IsStreamingClient() || e.method.Desc.IsStreamingServer() {
+		g.P("stream := ", e.stream)
+	}
+}
+
+// receive generates the loop receiving the responses of the stream s until io.EOF.
+func (e *example) receive(g *protogen.GeneratedFile) {
+	g.P("for {")
+	g.P("res, err := s.Recv()")
+	g.P("if err == ", ioPackage.Ident("EOF"), " {")
+	g.P("break")
+	g.P("}")
+	g.P(fmtPackage.Ident("Println"), "(res != nil, err)")
+	g.P("}")
+}
+
+// close generates the expected output of the example.
+func (e *example) close(g *protogen.GeneratedFile) {
+	switch {
+	case !e.method.Desc.IsStreamingClient():
+		g.P("// Output: true <nil>")
+	case !e.method.Desc.IsStreamingServer():
+		g.P("// Output:")
+		g.P("// <nil>")
+		g.P("// true <nil>")
+	default:
+		g.P("// Output:")
+		g.P("// <nil>")
+		g.P("// <nil>")
+		g.P("// true <nil>")
+	}
+	g.P("}")
+	g.P()
+}
+
+// Example generates an example for each method of the client mocks, which shows
+// how unary, server streaming, client streaming and bidirectional streaming
+// methods are stubbed with testify.
+func (tm *testifyMocker) Example(g *protogen.GeneratedFile, file *protogen.File) bool {
+	if tm.opts.Target != TargetGRPC {
+		return false
+	}
+	for _, service := range file.Services {
+		for _, method := range service.Methods {
+			e := newExample(g, method, tm.prefix(service))
+			e.open(g)
+			anything := g.QualifiedGoIdent(testifyMockPackage.Ident("Anything"))
+			ctx := g.QualifiedGoIdent(contextPackage.Ident("Background")) + "()"
+
+			switch {
+			case !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer():
+				g.P("m.On", method.GoName, "(", anything, ", ", anything, ").Return(&", e.out, "{}, nil)")
+				g.P()
+				g.P("res, err := m.", method.GoName, "(", ctx, ", &", e.in, "{})")
+				g.P(fmtPackage.Ident("Println"), "(res != nil, err)")
+			case !method.Desc.IsStreamingClient():
+				g.P("m.On", method.GoName, "(", anything, ", ", anything, ").Return(stream, nil)")
+				g.P("stream.OnRecv().Return(&", e.out, "{}, nil).Once()")
+				g.P("stream.OnRecv().Return((*", e.out, ")(nil), ", ioPackage.Ident("EOF"), ")")
+				g.P()
+				g.P("s, _ := m.", method.GoName, "(", ctx, ", &", e.in, "{})")
+				e.receive(g)
+			case !method.Desc.IsStreamingServer():
+				g.P("m.On", method.GoName, "(", anything, ").Return(stream, nil)")
+				g.P("stream.OnSend(", anything, ").Return(nil)")
+				g.P("stream.OnCloseAndRecv().Return(&", e.out, "{}, nil)")
+				g.P()
+				g.P("s, _ := m.", method.GoName, "(", ctx, ")")
+				g.P(fmtPackage.Ident("Println"), "(s.Send(&", e.in, "{}))")
+				g.P("res, err := s.CloseAndRecv()")
+				g.P(fmtPackage.Ident("Println"), "(res != nil, err)")
+			default:
+				g.P("m.On", method.GoName, "(", anything, ").Return(stream, nil)")
+				g.P("stream.OnSend(", anything, ").Return(nil)")
+				g.P("stream.On(\"CloseSend\").Return(nil)")
+				g.P("stream.OnRecv().Return(&", e.out, "{}, nil).Once()")
+				g.P("stream.OnRecv().Return((*", e.out, ")(nil), ", ioPackage.Ident("EOF"), ")")
+				g.P()
+				g.P("s, _ := m.", method.GoName, "(", ctx, ")")
+				g.P(fmtPackage.Ident("Println"), "(s.Send(&", e.in, "{}))")
+				g.P(fmtPackage.Ident("Println"), "(s.CloseSend())")
+				e.receive(g)
+			}
+			e.close(g)
+		}
+	}
+	return true
+}
+
+// Example generates an example for each method of the client mocks, which shows
+// how unary, server streaming, client streaming and bidirectional streaming
+// methods are stubbed with pegomock.
+func (pm *pegomockMocker) Example(g *protogen.GeneratedFile, file *protogen.File) bool {
+	if pm.opts.Target != TargetGRPC {
+		return false
+	}
+	for _, service := range file.Services {
+		for _, method := range service.Methods {
+			e := newExample(g, method, MockPrefix)
+			e.open(g)
+			when := g.QualifiedGoIdent(pegomockPackage.Ident("When"))
+			g.P("ctx, req := ", contextPackage.Ident("Background"), "(), &", e.in, "{}")
+
+			switch {
+			case !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer():
+				g.P(when, "(m.", method.GoName, "(ctx, req)).ThenReturn(&", e.out, "{}, nil)")
+				g.P()
+				g.P("res, err := m.", method.GoName, "(ctx, req)")
+				g.P(fmtPackage.Ident("Println"), "(res != nil, err)")
+			case !method.Desc.IsStreamingClient():
+				g.P(when, "(m.", method.GoName, "(ctx, req)).ThenReturn(stream, nil)")
+				g.P(when, "(stream.Recv()).ThenReturn(&", e.out, "{}, nil).ThenReturn((*", e.out, ")(nil), ", ioPackage.Ident("EOF"), ")")
+				g.P()
+				g.P("s, _ := m.", method.GoName, "(ctx, req)")
+				e.receive(g)
+			case !method.Desc.IsStreamingServer():
+				g.P(when, "(m.", method.GoName, "(ctx)).ThenReturn(stream, nil)")
+				g.P(when, "(stream.Send(req)).ThenReturn(nil)")
+				g.P(when, "(stream.CloseAndRecv()).ThenReturn(&", e.out, "{}, nil)")
+				g.P()
+				g.P("s, _ := m.", method.GoName, "(ctx)")
+				g.P(fmtPackage.Ident("Println"), "(s.Send(req))")
+				g.P("res, err := s.CloseAndRecv()")
+				g.P(fmtPackage.Ident("Println"), "(res != nil, err)")
+			default:
+				g.P(when, "(m.", method.GoName, "(ctx)).ThenReturn(stream, nil)")
+				g.P(when, "(stream.Send(req)).ThenReturn(nil)")
+				g.P(when, "(stream.CloseSend()).ThenReturn(nil)")
+				g.P(when, "(stream.Recv()).ThenReturn(&", e.out, "{}, nil).ThenReturn((*", e.out, ")(nil), ", ioPackage.Ident("EOF"), ")")
+				g.P()
+				g.P("s, _ := m.", method.GoName, "(ctx)")
+				g.P(fmtPackage.Ident("Println"), "(s.Send(req))")
+				g.P(fmtPackage.Ident("Println"), "(s.CloseSend())")
+				e.receive(g)
+			}
+			e.close(g)
+		}
+	}
+	return true
+}
@@ -0,0 +1,311 @@
+package framework
+
+import (
+	"strings"
+
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/internal/generator"
+)
+
+const (
+	FakePrefix = "Fake"
+
+	emptyMessage     = protoreflect.FullName("google.protobuf.Empty")
+	fieldMaskMessage = protoreflect.FullName("google.protobuf.FieldMask")
+)
+
+// standardMethod is an AIP standard method, see https://google.aip.dev/130.
+type standardMethod int
+
+const (
+	nonStandard standardMethod = iota
+	standardGet
+	standardList
+	standardCreate
+	standardUpdate
+	standardDelete
+)
+
+// resource is a message annotated with `google.api.resource`.
+type resource struct {
+	message    *protogen.Message
+	descriptor *annotations.ResourceDescriptor
+}
+
+// storeName returns the name of the field holding the resources in the fake.
+func (r *resource) storeName() string {
+	if plural := r.descriptor.GetPlural(); plural != "" {
+		return strings.ToUpper(plural[:1]) + plural[1:]
+	}
+	return r.message.GoIdent.GoName + "s"
+}
+
+// collection returns the collection identifier of the resource, e.g. `books`
+// for the pattern `shelves/{shelf}/books/{book}`.
+func (r *resource) collection() string {
+	for _, pattern := range r.descriptor.GetPattern() {
+		segments := strings.Split(pattern, "/")
+		if len(segments) >= 2 && strings.HasPrefix(segments[len(segments)-1], "{") {
+			return segments[len(segments)-2]
+		}
+	}
+	return ""
+}
+
+type fakeMocker struct{}
+
+func NewFakeMocker(_ Options) generator.Mocker {
+	return &fakeMocker{}
+}
+
+func (fm *fakeMocker) Name() string {
+	return "fake"
+}
+
+func (fm *fakeMocker) Mock(g *protogen.GeneratedFile, file *protogen.File) {
+	for _, service := range file.Services {
+		fm.generateService(g, file, service)
+	}
+}
+
+func (fm *fakeMocker) generateService(g *protogen.GeneratedFile, file *protogen.File, service *protogen.Service) {
+	fakeName := FakePrefix + service.GoName + ServerSuffix
+	deprecated := service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated()
+
+	methods := make(map[*protogen.Method]standardMethod)
+	resources := make(map[*protogen.Method]*resource)
+	var stores []*resource
+	for _, method := range service.Methods {
+		kind, res := fm.detect(method, stores)
+		if kind == nonStandard {
+			continue
+		}
+		methods[method] = kind
+		resources[method] = res
+		if !containsResource(stores, res) {
+			stores = append(stores, res)
+		}
+	}
+	// Delete methods returning google.protobuf.Empty are only detected by the
+	// resources of the other standard methods.
+	for _, method := range service.Methods {
+		if _, ok := methods[method]; ok {
+			continue
+		}
+		if kind, res := fm.detect(method, stores); kind != nonStandard {
+			methods[method] = kind
+			resources[method] = res
+		}
+	}
+
+	// Fake structure.
+	g.P("// ", fakeName, " is an in-memory implementation of ", service.GoName, ServerSuffix, ".")
+	g.P("// It implements the AIP standard methods of the service; all other methods")
+	g.P("// return codes.Unimplemented.")
+	if deprecated {
+		g.P("//")
+		g.P(deprecationComment)
+	}
+	g.P("type ", fakeName, " struct {")
+	g.P(g.QualifiedGoIdent(grpcIdent(file, "Unimplemented"+service.GoName+ServerSuffix)))
+	if len(stores) > 0 {
+		g.P()
+	}
+	for _, res := range stores {
+		g.P(res.storeName(), " *", grpcmockPackage.Ident("Store"), "[*", res.message.GoIdent, "]")
+	}
+	g.P("}")
+	g.P()
+
+	// NewFake factory.
+	if deprecated {
+		g.P(deprecationComment)
+	}
+	g.P("func New", fakeName, "() *", fakeName, " {")
+	g.P("return &", fakeName, "{")
+	for _, res := range stores {
+		g.P(res.storeName(), ": ", grpcmockPackage.Ident("NewStore"), "[*", res.message.GoIdent, "](),")
+	}
+	g.P("}")
+	g.P("}")
+	g.P()
+
+	g.P("var _ ", grpcIdent(file, service.GoName+ServerSuffix), " = (*", fakeName, ")(nil)")
+	g.P()
+
+	// Standard method implementations.
+	for _, method := range service.Methods {
+		kind, ok := methods[method]
+		if !ok {
+			continue
+		}
+		res := resources[method]
+		store := "s." + res.storeName()
+
+		if method.Desc.Options().(*descriptorpb.MethodOptions).GetDeprecated() {
+			g.P(deprecationComment)
+		}
+		g.P("func (s *", fakeName, ") ", method.GoName, "(ctx ", contextPackage.Ident("Context"), ", in *", method.Input.GoIdent, ") (*", method.Output.GoIdent, ", error) {")
+		switch kind {
+		case standardGet:
+			g.P("return ", store, ".Get(in.Get", field(method.Input, "name").GoName, "())")
+		case standardList:
+			parent := `""`
+			if f := field(method.Input, "parent"); f != nil {
+				parent = "in.Get" + f.GoName + "()"
+			}
+			g.P("resources, next, err := ", store, ".List(", parent, ", in.Get", field(method.Input, "page_size").GoName,
+				"(), in.Get", field(method.Input, "page_token").GoName, "())")
+			g.P("if err != nil {")
+			g.P("return nil, err")
+			g.P("}")
+			g.P("return &", method.Output.GoIdent, "{", resourceField(method.Output, res, true).GoName, ": resources, ",
+				field(method.Output, "next_page_token").GoName, ": next}, nil")
+		case standardCreate:
+			resField := resourceField(method.Input, res, false)
+			g.P("r := &", res.message.GoIdent, "{}")
+			g.P(protoPackage.Ident("Merge"), "(r, in.Get", resField.GoName, "())")
+			if collection := res.collection(); collection != "" {
+				parent := `""`
+				if f := field(method.Input, "parent"); f != nil {
+					parent = "in.Get" + f.GoName + "()"
+				}
+				if f := field(method.Input, resField.Desc.Name()+"_id"); f != nil {
+					g.P("id := in.Get", f.GoName, "()")
+					g.P("if id == \"\" {")
+					g.P("id = ", store, ".NewID()")
+					g.P("}")
+				} else {
+					g.P("id := ", store, ".NewID()")
+				}
+				g.P("r.", field(res.message, "name").GoName, " = ", grpcmockPackage.Ident("ResourceName"), "(", parent, ", \"", collection, "\", id)")
+			}
+			g.P("return ", store, ".Create(r)")
+		case standardUpdate:
+			mask := "nil"
+			if f := field(method.Input, "update_mask"); f != nil {
+				mask = "in.Get" + f.GoName + "()"
+			}
+			g.P("return ", store, ".Update(in.Get", resourceField(method.Input, res, false).GoName, "(), ", mask, ")")
+		case standardDelete:
+			if method.Output.Desc.FullName() == emptyMessage {
+				g.P("if _, err := ", store, ".Delete(in.Get", field(method.Input, "name").GoName, "()); err != nil {")
+				g.P("return nil, err")
+				g.P("}")
+				g.P("return &", method.Output.GoIdent, "{}, nil")
+			} else {
+				g.P("return ", store, ".Delete(in.Get", field(method.Input, "name").GoName, "())")
+			}
+		}
+		g.P("}")
+		g.P()
+	}
+}
+
+// detect detects the AIP standard method and its resource by the method name
+// and the fields of the request and response messages. Delete methods returning
+// google.protobuf.Empty are only detected, if their resource is one of the known ones.
+func (fm *fakeMocker) detect(method *protogen.Method, known []*resource) (standardMethod, *resource) {
+	if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+		return nonStandard, nil
+	}
+
+	name := method.GoName
+	out := resourceOf(method.Output)
+	switch {
+	case strings.HasPrefix(name, "Get"):
+		if out != nil && isString(field(method.Input, "name")) {
+			return standardGet, out
+		}
+	case strings.HasPrefix(name, "List"):
+		for _, f := range method.Output.Fields {
+			if res := resourceOf(f.Message); res != nil && f.Desc.IsList() &&
+				isString(field(method.Output, "next_page_token")) &&
+				isString(field(method.Input, "page_token")) &&
+				field(method.Input, "page_size") != nil && field(method.Input, "page_size").Desc.Kind() == protoreflect.Int32Kind &&
+				(field(method.Input, "parent") == nil || isString(field(method.Input, "parent"))) {
+				return standardList, res
+			}
+		}
+	case strings.HasPrefix(name, "Create"):
+		if out != nil && resourceField(method.Input, out, false) != nil {
+			return standardCreate, out
+		}
+	case strings.HasPrefix(name, "Update"):
+		mask := field(method.Input, "update_mask")
+		if out != nil && resourceField(method.Input, out, false) != nil &&
+			(mask == nil || (mask.Message != nil && mask.Message.Desc.FullName() == fieldMaskMessage)) {
+			return standardUpdate, out
+		}
+	case strings.HasPrefix(name, "Delete"):
+		if !isString(field(method.Input, "name")) {
+			break
+		}
+		if out != nil {
+			return standardDelete, out
+		}
+		if method.Output.Desc.FullName() == emptyMessage {
+			for _, res := range known {
+				if res.message.GoIdent.GoName == strings.TrimPrefix(name, "Delete") {
+					return standardDelete, res
+				}
+			}
+		}
+	}
+	return nonStandard, nil
+}
+
+// resourceOf returns the resource of the message, or nil if the message is not
+// annotated with `google.api.resource` or has no string field `name`.
+func resourceOf(msg *protogen.Message) *resource {
+	if msg == nil {
+		return nil
+	}
+	desc, _ := proto.GetExtension(msg.Desc.Options(), annotations.E_Resource).(*annotations.ResourceDescriptor)
+	if desc == nil || !isString(field(msg, "name")) {
+		return nil
+	}
+	return &resource{message: msg, descriptor: desc}
+}
+
+// resourceField returns the singular or repeated field of the message holding the resource.
+func resourceField(msg *protogen.Message, res *resource, repeated bool) *protogen.Field {
+	for _, f := range msg.Fields {
+		if f.Message != nil && f.Message.Desc.FullName() == res.message.Desc.FullName() && f.Desc.IsList() == repeated {
+			return f
+		}
+	}
+	return nil
+}
+
+// field returns the field with the given name, or nil if the message has no such field.
+func field(msg *protogen.Message, name protoreflect.Name) *protogen.Field {
+	for _, f := range msg.Fields {
+		if f.Desc.Name() == name {
+			return f
+		}
+	}
+	return nil
+}
+
+func isString(f *protogen.Field) bool {
+	return f != nil && !f.Desc.IsList() && f.Desc.Kind() == protoreflect.StringKind
+}
+
+func containsResource(resources []*resource, res *resource) bool {
+	for _, r := range resources {
+		if r.message == res.message {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	setMocker("fake", NewFakeMocker)
+}
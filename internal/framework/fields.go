@@ -0,0 +1,56 @@
+package framework
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const (
+	fieldmaskPackage    = protogen.GoImportPath("google.golang.org/protobuf/types/known/fieldmaskpb")
+	protoreflectPackage = protogen.GoImportPath("google.golang.org/protobuf/reflect/protoreflect")
+)
+
+// generateFieldNames generates the type <Message>Field and a variable of this
+// type for the name of each field of the message. The type is a struct with an
+// unexported field, so the names passed to the Eq<Message>Ignoring matchers are
+// validated by the compiler and an untyped string cannot be converted to it.
+func generateFieldNames(g *protogen.GeneratedFile, msg *protogen.Message) {
+	typeName := msg.GoIdent.GoName + "Field"
+	g.P("// ", typeName, " is the name of a field of ", msg.GoIdent.GoName, ".")
+	g.P("type ", typeName, " struct {")
+	g.P("name ", protoreflectPackage.Ident("Name"))
+	g.P("}")
+	g.P()
+	g.P("// FieldName returns the name of the field.")
+	g.P("func (f ", typeName, ") FieldName() ", protoreflectPackage.Ident("Name"), " {")
+	g.P("return f.name")
+	g.P("}")
+	g.P()
+	if len(msg.Fields) == 0 {
+		return
+	}
+	g.P("var (")
+	for _, field := range msg.Fields {
+		g.P(typeName, "_", field.GoName, " = ", typeName, "{name: \"", field.Desc.Name(), "\"}")
+	}
+	g.P(")")
+	g.P()
+}
+
+// generateFieldMatchers generates the Eq<Message>Ignoring and Eq<Message>Masked
+// matchers of all messages of the file. The matchers are of type ret and wrap
+// the predicates of the grpcmock package with the function matcher.
+func generateFieldMatchers(g *protogen.GeneratedFile, file *protogen.File, ret func(*protogen.Message) string, matcher protogen.GoIdent) {
+	for _, msg := range file.Messages {
+		generateFieldNames(g, msg)
+
+		g.P("func Eq", msg.GoIdent.GoName, "Ignoring(v *", msg.GoIdent, ", fields ...", msg.GoIdent.GoName, "Field) ", ret(msg), " {")
+		g.P("return ", matcher, "(", grpcmockPackage.Ident("MustMatch"), "(", grpcmockPackage.Ident("IgnoringFields"), "(v, fields...)))")
+		g.P("}")
+		g.P()
+
+		g.P("func Eq", msg.GoIdent.GoName, "Masked(v *", msg.GoIdent, ", mask *", fieldmaskPackage.Ident("FieldMask"), ") ", ret(msg), " {")
+		g.P("return ", matcher, "(", grpcmockPackage.Ident("MustMatch"), "(", grpcmockPackage.Ident("MaskedFields"), "(v, mask)))")
+		g.P("}")
+		g.P()
+	}
+}
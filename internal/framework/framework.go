@@ -3,6 +3,7 @@
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
@@ -11,28 +12,150 @@
 )
 
 const (
-	MockPrefix   = "Mock"
-	ClientSuffix = "Client"
-	ServerSuffix = "Server"
+	MockPrefix    = "Mock"
+	ClientSuffix  = "Client"
+	ServerSuffix  = "Server"
+	HandlerSuffix = "Handler"
 
-	contextPackage = protogen.GoImportPath("context")
-	grpcPackage    = protogen.GoImportPath("google.golang.org/grpc")
+	// TargetGRPC generates mocks for the interfaces generated by protoc-gen-go-grpc.
+	TargetGRPC = "grpc"
+	// TargetConnect generates mocks for the interfaces generated by protoc-gen-connect-go.
+	TargetConnect = "connect"
+	// TargetTwirp generates mocks for the interfaces generated by protoc-gen-twirp.
+	TargetTwirp = "twirp"
+
+	contextPackage      = protogen.GoImportPath("context")
+	grpcPackage         = protogen.GoImportPath("google.golang.org/grpc")
+	grpcmockPackage     = protogen.GoImportPath("github.com/lovoo/protoc-gen-go-grpcmock/grpcmock")
+	testifymockPackage  = protogen.GoImportPath("github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/testifymock")
+	pegomockmockPackage = protogen.GoImportPath("github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/pegomockmock")
+	gomegamockPackage   = protogen.GoImportPath("github.com/lovoo/protoc-gen-go-grpcmock/grpcmock/gomegamock")
+	protoPackage        = protogen.GoImportPath("google.golang.org/protobuf/proto")
+	testingPackage      = protogen.GoImportPath("testing")
 )
 
-var mocker = make(map[string]func() generator.Mocker)
+// Options configure the code generated by the mockers.
+type Options struct {
+	// Target is the RPC stack to generate mocks for. It defaults to TargetGRPC.
+	Target string
+	// Gomega enables the generation of Gomega matchers for all messages and methods.
+	Gomega bool
+	// TestingTB enables the generation of constructors of the mocks and scripts, which
+	// accept a testing.TB used to report failures and to assert the expectations on cleanup.
+	TestingTB bool
+	// Lenient changes the testify mocks to return codes.Unimplemented for calls
+	// without a matching expectation instead of panicking.
+	// It is only supported by the testify mocker and TargetGRPC.
+	Lenient bool
+	// Suite enables the generation of a testify suite for each service.
+	// It is only supported by the testify mocker and TargetGRPC.
+	Suite bool
+}
+
+// registration is a registered mocker and the targets it supports.
+type registration struct {
+	ctor    func(Options) generator.Mocker
+	targets []string
+}
 
-var errUnknownMocker = errors.New("protoc-gen-go-grpcmock: unknown test framework")
+var mocker = make(map[string]registration)
+
+// targetOutput returns the output of the mocks of the file for the target.
+func targetOutput(file *protogen.File, target string) generator.Output {
+	switch target {
+	case TargetConnect:
+		return connectOutput(file)
+	case TargetTwirp:
+		return twirpOutput(file)
+	default:
+		return generator.DefaultOutput(file)
+	}
+}
 
-func Mocker(name string) (generator.Mocker, error) {
+var (
+	errUnknownMocker     = errors.New("protoc-gen-go-grpcmock: unknown test framework")
+	errUnsupportedTarget = errors.New("protoc-gen-go-grpcmock: unsupported target")
+	errUnsupportedOption = errors.New("protoc-gen-go-grpcmock: unsupported option")
+)
+
+func Mocker(name string, opts Options) (generator.Mocker, error) {
 	m, ok := mocker[name]
 	if !ok {
 		return nil, fmt.Errorf("%w %q. Please use one of the following: [%s]", errUnknownMocker, name, availableMocker())
 	}
-	return m(), nil
+	if opts.Target == "" {
+		opts.Target = TargetGRPC
+	}
+	if !slices.Contains(m.targets, opts.Target) {
+		return nil, fmt.Errorf("%w %q for test framework %q. Please use one of the following: [%s]", errUnsupportedTarget, opts.Target, name, strings.Join(m.targets, ", "))
+	}
+	if err := validateOptions(name, opts); err != nil {
+		return nil, err
+	}
+	return m.ctor(opts), nil
 }
 
-func setMocker(name string, ctor func() generator.Mocker) {
-	mocker[name] = ctor
+// validateOptions reports an error, if an option is set, which the mocker does
+// not support for the target.
+func validateOptions(name string, opts Options) error {
+	for _, option := range []struct {
+		name string
+		set  bool
+	}{
+		{"lenient", opts.Lenient},
+		{"suite", opts.Suite},
+	} {
+		if option.set && (name != "testify" || opts.Target != TargetGRPC) {
+			return unsupportedOption(option.name, name, opts.Target)
+		}
+	}
+	if name != "fake" {
+		return nil
+	}
+	// The fakes have no expectations to assert or report failures of.
+	for _, option := range []struct {
+		name string
+		set  bool
+	}{
+		{"gomega", opts.Gomega},
+		{"testing_tb", opts.TestingTB},
+	} {
+		if option.set {
+			return fmt.Errorf("%w %q for test framework %q", errUnsupportedOption, option.name, name)
+		}
+	}
+	return nil
+}
+
+// unsupportedOption returns the error of an option, which is only supported by
+// the testify mocks of gRPC services.
+func unsupportedOption(option, name, target string) error {
+	return fmt.Errorf("%w %q for test framework %q and target %q. It is only supported by the test framework \"testify\" and the target %q", errUnsupportedOption, option, name, target, TargetGRPC)
+}
+
+// ValidateConfig reports an error, if the config enables an option, which the
+// mocker does not support for the target.
+func ValidateConfig(name string, opts Options, config *generator.Config) error {
+	if opts.Target == "" {
+		opts.Target = TargetGRPC
+	}
+	opts.Lenient = config.EnablesLenient()
+	if err := validateOptions(name, opts); err != nil {
+		return err
+	}
+	if config.SetsPrefix() && (name != "testify" || opts.Target != TargetGRPC) {
+		return unsupportedOption("prefix", name, opts.Target)
+	}
+	return nil
+}
+
+// setMocker registers the mocker, which supports the targets.
+// Mockers without targets only support TargetGRPC.
+func setMocker(name string, ctor func(Options) generator.Mocker, targets ...string) {
+	if len(targets) == 0 {
+		targets = []string{TargetGRPC}
+	}
+	mocker[name] = registration{ctor: ctor, targets: targets}
 }
 
 func availableMocker() string {
@@ -0,0 +1,33 @@
+package framework
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const gomegaTypesPackage = protogen.GoImportPath("github.com/onsi/gomega/types")
+
+// generateGomegaMatchers generates Gomega matchers for all messages of the file
+// and for the methods of all services. The matchers of the methods verify the
+// calls with the Received function of the framework package pkg.
+func generateGomegaMatchers(g *protogen.GeneratedFile, file *protogen.File, decls declarations, pkg protogen.GoImportPath) {
+	for _, msg := range file.Messages {
+		g.P("func Equal", msg.GoIdent.GoName, "(v *", msg.GoIdent, ") ", gomegaTypesPackage.Ident("GomegaMatcher"), " {")
+		g.P("return ", gomegamockPackage.Ident("EqualProto"), "(v)")
+		g.P("}")
+		g.P()
+	}
+
+	// Services may share method names, but the matchers only depend on the name.
+	for _, service := range file.Services {
+		for _, method := range service.Methods {
+			if !decls.declare("HaveReceived" + method.GoName) {
+				continue
+			}
+
+			g.P("func HaveReceived", method.GoName, "(args ...interface{}) ", gomegaTypesPackage.Ident("GomegaMatcher"), " {")
+			g.P("return ", gomegamockPackage.Ident("HaveReceived"), "(", pkg.Ident("Received"), ", \"", method.GoName, "\", args...)")
+			g.P("}")
+			g.P()
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package framework
+
+import (
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// generateInterceptors generates the constructors of clients and servers, which
+// run gRPC interceptors with the full method names before a call reaches the
+// wrapped client or server, usually a mock.
+// The generated code does not depend on the mocking framework.
+func generateInterceptors(g *protogen.GeneratedFile, file *protogen.File, service *protogen.Service) {
+	deprecated := service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated()
+
+	clientInterface := g.QualifiedGoIdent(grpcIdent(file, service.GoName+ClientSuffix))
+	clientName := "intercepted" + service.GoName + ClientSuffix
+	g.P("type ", clientName, " struct {")
+	g.P("client ", clientInterface)
+	g.P("interceptors ", grpcmockPackage.Ident("ClientInterceptors"))
+	g.P("}")
+	g.P()
+	if deprecated {
+		g.P(deprecationComment)
+	}
+	g.P("func New", service.GoName, ClientSuffix, "WithInterceptors(client ", clientInterface, ", interceptors ", grpcmockPackage.Ident("ClientInterceptors"), ") ", clientInterface, " {")
+	g.P("return &", clientName, "{client: client, interceptors: interceptors}")
+	g.P("}")
+	g.P()
+	for _, method := range service.Methods {
+		generateInterceptedClientMethod(g, file, method, clientName)
+	}
+
+	serverInterface := g.QualifiedGoIdent(grpcIdent(file, service.GoName+ServerSuffix))
+	serverName := "intercepted" + service.GoName + ServerSuffix
+	g.P("type ", serverName, " struct {")
+	g.P(unimplementedServer(file, service))
+	g.P("server ", serverInterface)
+	g.P("interceptors ", grpcmockPackage.Ident("ServerInterceptors"))
+	g.P("}")
+	g.P()
+	if deprecated {
+		g.P(deprecationComment)
+	}
+	g.P("func New", service.GoName, ServerSuffix, "WithInterceptors(server ", serverInterface, ", interceptors ", grpcmockPackage.Ident("ServerInterceptors"), ") ", serverInterface, " {")
+	g.P("return &", serverName, "{server: server, interceptors: interceptors}")
+	g.P("}")
+	g.P()
+	for _, method := range service.Methods {
+		generateInterceptedServerMethod(g, file, method, serverName)
+	}
+}
+
+// streamDesc returns the expression of the grpc.StreamDesc of a streaming method.
+func streamDesc(g *protogen.GeneratedFile, method *protogen.Method) string {
+	return "&" + g.QualifiedGoIdent(grpcPackage.Ident("StreamDesc")) + "{StreamName: \"" + string(method.Desc.Name()) +
+		"\", ClientStreams: " + strconv.FormatBool(method.Desc.IsStreamingClient()) +
+		", ServerStreams: " + strconv.FormatBool(method.Desc.IsStreamingServer()) + "}"
+}
+
+func generateInterceptedClientMethod(g *protogen.GeneratedFile, file *protogen.File, method *protogen.Method, typeName string) {
+	ctx := g.QualifiedGoIdent(contextPackage.Ident("Context"))
+	callOption := g.QualifiedGoIdent(grpcPackage.Ident("CallOption"))
+	in, out := g.QualifiedGoIdent(method.Input.GoIdent), g.QualifiedGoIdent(method.Output.GoIdent)
+
+	if !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer() {
+		g.P("func (c *", typeName, ") ", method.GoName, "(ctx ", ctx, ", in *", in, ", opts ...", callOption, ") (*", out, ", error) {")
+		g.P("return ", grpcmockPackage.Ident("InterceptUnaryClient"), "(ctx, c.interceptors.Unary, \"", fullMethodName(method), "\", in, opts, c.client.", method.GoName, ")")
+		g.P("}")
+		g.P()
+		return
+	}
+
+	stream := g.QualifiedGoIdent(grpcIdent(file, method.Parent.GoName+"_"+method.GoName+ClientSuffix))
+	if method.Desc.IsStreamingClient() {
+		g.P("func (c *", typeName, ") ", method.GoName, "(ctx ", ctx, ", opts ...", callOption, ") (", stream, ", error) {")
+	} else {
+		g.P("func (c *", typeName, ") ", method.GoName, "(ctx ", ctx, ", in *", in, ", opts ...", callOption, ") (", stream, ", error) {")
+	}
+	g.P("return ", grpcmockPackage.Ident("InterceptStreamClient"), "(ctx, c.interceptors.Stream, ", streamDesc(g, method), ", \"", fullMethodName(method), "\", opts,")
+	if method.Desc.IsStreamingClient() {
+		g.P("c.client.", method.GoName, ",")
+	} else {
+		g.P("func(ctx ", ctx, ", opts ...", callOption, ") (", stream, ", error) {")
+		g.P("return c.client.", method.GoName, "(ctx, in, opts...)")
+		g.P("},")
+	}
+	g.P("func(s ", grpcPackage.Ident("ClientStream"), ") ", stream, " {")
+	g.P("return &", grpcmockPackage.Ident("InterceptedClientStream"), "[", in, ", ", out, "]{ClientStream: s}")
+	g.P("})")
+	g.P("}")
+	g.P()
+}
+
+func generateInterceptedServerMethod(g *protogen.GeneratedFile, file *protogen.File, method *protogen.Method, typeName string) {
+	in, out := g.QualifiedGoIdent(method.Input.GoIdent), g.QualifiedGoIdent(method.Output.GoIdent)
+
+	if !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer() {
+		g.P("func (s *", typeName, ") ", method.GoName, "(ctx ", contextPackage.Ident("Context"), ", in *", in, ") (*", out, ", error) {")
+		g.P("return ", grpcmockPackage.Ident("InterceptUnaryServer"), "(ctx, s.interceptors.Unary, s.server, \"", fullMethodName(method), "\", in, s.server.", method.GoName, ")")
+		g.P("}")
+		g.P()
+		return
+	}
+
+	stream := g.QualifiedGoIdent(grpcIdent(file, method.Parent.GoName+"_"+method.GoName+ServerSuffix))
+	if method.Desc.IsStreamingClient() {
+		g.P("func (s *", typeName, ") ", method.GoName, "(stream ", stream, ") error {")
+	} else {
+		g.P("func (s *", typeName, ") ", method.GoName, "(in *", in, ", stream ", stream, ") error {")
+	}
+	g.P("return ", grpcmockPackage.Ident("InterceptStreamServer"), "(s.interceptors.Stream, s.server, ", streamDesc(g, method), ", \"", fullMethodName(method), "\", stream,")
+	if method.Desc.IsStreamingClient() {
+		g.P("s.server.", method.GoName, ",")
+	} else {
+		g.P("func(stream ", stream, ") error {")
+		g.P("return s.server.", method.GoName, "(in, stream)")
+		g.P("},")
+	}
+	g.P("func(ss ", grpcPackage.Ident("ServerStream"), ") ", stream, " {")
+	g.P("return &", grpcmockPackage.Ident("InterceptedServerStream"), "[", in, ", ", out, "]{ServerStream: ss}")
+	g.P("})")
+	g.P("}")
+	g.P()
+}
@@ -1,7 +1,10 @@
 package framework
 
 import (
+	"path"
+	"sort"
 	"strings"
+	"unicode"
 
 	"github.com/petergtz/pegomock/mockgen"
 	"github.com/petergtz/pegomock/model"
@@ -10,20 +13,54 @@
 	"github.com/lovoo/protoc-gen-go-grpcmock/internal/generator"
 )
 
-const metadataPackage = protogen.GoImportPath("google.golang.org/grpc/metadata")
+const (
+	metadataPackage = protogen.GoImportPath("google.golang.org/grpc/metadata")
+	pegomockPackage = protogen.GoImportPath("github.com/petergtz/pegomock")
+	reflectPackage  = protogen.GoImportPath("reflect")
+)
 
-type pegomockMocker struct{}
+type pegomockMocker struct {
+	opts Options
+}
 
-func NewPegomockMocker() generator.Mocker {
-	return &pegomockMocker{}
+func NewPegomockMocker(opts Options) generator.Mocker {
+	return &pegomockMocker{opts: opts}
 }
 
 func (pm *pegomockMocker) Name() string {
 	return "pegomock"
 }
 
+func (pm *pegomockMocker) Output(file *protogen.File) generator.Output {
+	return targetOutput(file, pm.opts.Target)
+}
+
 func (pm *pegomockMocker) Mock(g *protogen.GeneratedFile, file *protogen.File) {
-	matchers := make(map[string]string)
+	pm.MockPackage(g, []*protogen.File{file})
+}
+
+func (pm *pegomockMocker) MockPackage(g *protogen.GeneratedFile, files []*protogen.File) {
+	decls := make(declarations)
+	for _, file := range files {
+		switch pm.opts.Target {
+		case TargetConnect:
+			pm.mockConnect(g, file)
+		case TargetTwirp:
+			pm.mockTwirp(g, file, decls)
+		default:
+			pm.mockGRPC(g, file, decls)
+		}
+
+		generateCaptors(g, file, pegomockmockPackage)
+		generateFieldMatchers(g, file, func(msg *protogen.Message) string { return "*" + g.QualifiedGoIdent(msg.GoIdent) }, pegomockmockPackage.Ident("ArgThat"))
+		if pm.opts.Gomega {
+			generateGomegaMatchers(g, file, decls, pegomockmockPackage)
+		}
+	}
+}
+
+func (pm *pegomockMocker) mockGRPC(g *protogen.GeneratedFile, file *protogen.File, decls declarations) {
+	matchers := make(argMatchers)
 
 	for _, service := range file.Services {
 		pkg := string(file.GoPackageName)
@@ -39,41 +76,184 @@ func (pm *pegomockMocker) Mock(g *protogen.GeneratedFile, file *protogen.File) {
 			},
 		}
 
+		// The mocks of the streams embed the generic mocks of the pegomockmock package,
+		// so only the matchers for the arguments of the streams are generated.
+		var streams []*model.Interface
 		for _, method := range service.Methods {
 			if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
-				interfaces = append(interfaces, pm.generateClientStreamHandler(method))
-				interfaces = append(interfaces, pm.generateServerStreamHandler(method))
+				streams = append(streams, pm.generateClientStreamHandler(method))
+				streams = append(streams, pm.generateServerStreamHandler(method))
 			}
 		}
 
-		ast := &model.Package{
-			Name:       pkg,
-			Interfaces: interfaces,
+		data, _ := mockgen.GenerateOutput(&model.Package{Name: pkg, Interfaces: interfaces}, file.Desc.Path(), "", pkg, string(file.GoImportPath))
+		matchers.add(interfaces...)
+		matchers.add(streams...)
+
+		output := mustParseGoSource(data)
+
+		// The server mock embeds the unimplemented server to satisfy the server interface.
+		if st := output.structType(MockPrefix + service.GoName + ServerSuffix); st != nil {
+			output.insert(st.Fields.Opening+1, "\n"+g.QualifiedGoIdent(unimplementedServer(file, service)))
+		}
+		pm.withCoverage(g, output, service, service.GoName+ClientSuffix, service.GoName+ServerSuffix)
+		output.generate(g)
+		if pm.opts.TestingTB {
+			pm.generateTestingTB(g, interfaces)
+		}
+
+		for _, method := range service.Methods {
+			if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+				pm.generateStream(g, method, ClientSuffix)
+				pm.generateStream(g, method, ServerSuffix)
+			}
 		}
 
-		data, types := mockgen.GenerateOutput(ast, file.Desc.Path(), "", pkg, string(file.GoImportPath))
+		generateScripts(g, service, pm.opts)
+		generatePipes(g, service)
+		generateInterceptors(g, file, service)
+		generateAssertions(g, file, service, MockPrefix)
+	}
+
+	pm.generateMatchers(g, matchers, decls)
+}
 
-		for t, matcher := range types {
-			matchers[t] = strings.ReplaceAll(matcher, pkg+".", "")
+// argMatchers are the types of the parameters of the mocked interfaces, for which
+// argument matchers are generated, by the names of their matchers.
+type argMatchers map[string]model.Type
+
+// add adds the types of the parameters and results of the methods of the
+// interfaces like pegomock does. The types of the packages context and grpc are
+// excluded, since they are not unique to a .proto file.
+func (a argMatchers) add(interfaces ...*model.Interface) {
+	for _, iface := range interfaces {
+		for _, method := range iface.Methods {
+			params := append(append([]*model.Parameter(nil), method.In...), method.Out...)
+			if method.Variadic != nil {
+				params = append(params, method.Variadic)
+			}
+			for _, param := range params {
+				named := param.Type
+				if ptr, ok := named.(*model.PointerType); ok {
+					named = ptr.Type
+				}
+				if nt, ok := named.(*model.NamedType); ok && nt.Package != string(contextPackage) && nt.Package != string(grpcPackage) {
+					a[matcherName(param.Type)] = param.Type
+				}
+			}
 		}
+	}
+}
 
-		// Strip the header comment and package name.
-		// Package names are kept.
-		g.P(substringAfter(string(data), "package "+pkg))
+// matcherName returns the name of the argument matchers of the type like
+// pegomock, for example PtrToRouteguidePoint for *routeguide.Point.
+func matcherName(t model.Type) string {
+	switch t := t.(type) {
+	case *model.PointerType:
+		return "PtrTo" + matcherName(t.Type)
+	case *model.NamedType:
+		words := strings.FieldsFunc(path.Base(t.Package)+"_"+t.Type, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		for i, word := range words {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+		return strings.Join(words, "")
+	default:
+		return ""
 	}
+}
 
-	for t, matcher := range matchers {
-		// The types context.Context and grpc.* must be excluded, since
-		// they are not unique to a .proto file.
-		if t == "context_context" || strings.HasPrefix(t, "grpc_") {
+// goType returns the type qualified by the generated file.
+func goType(g *protogen.GeneratedFile, t model.Type) string {
+	switch t := t.(type) {
+	case *model.PointerType:
+		return "*" + goType(g, t.Type)
+	case *model.NamedType:
+		return g.QualifiedGoIdent(protogen.GoImportPath(t.Package).Ident(t.Type))
+	default:
+		return t.String(nil, "")
+	}
+}
+
+// generateMatchers generates the Any<Type>, Eq<Type>, NotEq<Type> and <Type>That
+// argument matchers, which pegomock generates for the types, with the generic
+// matchers of the pegomockmock package, unless they were generated for another
+// file already.
+func (pm *pegomockMocker) generateMatchers(g *protogen.GeneratedFile, matchers argMatchers, decls declarations) {
+	// The matchers are sorted by name to generate the same output on each run.
+	names := make([]string, 0, len(matchers))
+	for name := range matchers {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return strings.ToLower(names[i]) < strings.ToLower(names[j]) })
+
+	for _, name := range names {
+		if !decls.declare(name) {
 			continue
 		}
+		typ := goType(g, matchers[name])
+
+		g.P("func Any", name, "() ", typ, " {")
+		g.P("return ", pegomockmockPackage.Ident("AnyArg"), "[", typ, "]()")
+		g.P("}")
+		g.P()
+		g.P("func Eq", name, "(value ", typ, ") ", typ, " {")
+		g.P("return ", pegomockmockPackage.Ident("EqArg"), "[", typ, "](value)")
+		g.P("}")
+		g.P()
+		g.P("func NotEq", name, "(value ", typ, ") ", typ, " {")
+		g.P("return ", pegomockmockPackage.Ident("NotEqArg"), "[", typ, "](value)")
+		g.P("}")
+		g.P()
+		g.P("func ", name, "That(matcher ", pegomockPackage.Ident("ArgumentMatcher"), ") ", typ, " {")
+		g.P("return ", pegomockmockPackage.Ident("MatchArg"), "[", typ, "](matcher)")
+		g.P("}")
+		g.P()
+	}
+}
 
-		// Strip the header comment, package name and imports.
-		g.P(substringAfter(matcher, ")"))
+// generateStream generates the mock of the client or server stream of the method,
+// which embeds the generic mock of the pegomockmock package.
+func (pm *pegomockMocker) generateStream(g *protogen.GeneratedFile, method *protogen.Method, suffix string) {
+	typeName := MockPrefix + method.Parent.GoName + "_" + method.GoName + suffix
+	g.P("type ", typeName, " struct {")
+	g.P(streamMock(g, method, pegomockmockPackage, suffix))
+	g.P("}")
+	g.P()
+
+	g.P("func New", typeName, "(options ...", pegomockPackage.Ident("Option"), ") *", typeName, " {")
+	g.P("mock := &", typeName, "{}")
+	g.P("for _, option := range options {")
+	g.P("option.Apply(mock)")
+	g.P("}")
+	g.P("return mock")
+	g.P("}")
+	g.P()
+	if pm.opts.TestingTB {
+		pm.generateNewTFunc(g, typeName)
 	}
 }
 
+// generateTestingTB generates the constructors of the mocks of the interfaces,
+// which accept a testing.TB used as fail handler of the mock, next to the
+// constructors generated by pegomock.
+func (pm *pegomockMocker) generateTestingTB(g *protogen.GeneratedFile, interfaces []*model.Interface) {
+	for _, iface := range interfaces {
+		pm.generateNewTFunc(g, MockPrefix+iface.Name)
+	}
+}
+
+// generateNewTFunc generates the constructor of the mock, which accepts a
+// testing.TB. The options passed to it override the fail handler.
+func (pm *pegomockMocker) generateNewTFunc(g *protogen.GeneratedFile, typeName string) {
+	option := g.QualifiedGoIdent(pegomockPackage.Ident("Option"))
+	g.P("func New", typeName, "T(t ", testingPackage.Ident("TB"), ", options ...", option, ") *", typeName, " {")
+	g.P("return New", typeName, "(append([]", option, "{", pegomockPackage.Ident("WithT"), "(t)}, options...)...)")
+	g.P("}")
+	g.P()
+}
+
 func (pm *pegomockMocker) clientMethod(method *protogen.Method) *model.Method {
 	m := &model.Method{
 		Name: method.GoName,
@@ -412,5 +592,5 @@ func (pm *pegomockMocker) qualifiedGoIdent(ident protogen.GoIdent) model.Type {
 }
 
 func init() {
-	setMocker("pegomock", NewPegomockMocker)
+	setMocker("pegomock", NewPegomockMocker, TargetGRPC, TargetConnect, TargetTwirp)
 }
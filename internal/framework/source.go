@@ -0,0 +1,174 @@
+package framework
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// goSource is Go source code generated by a third-party generator, whose
+// declarations are emitted to a protogen.GeneratedFile. The package references
+// are qualified by the generated file, so the imports are tracked by protogen
+// and never duplicated.
+type goSource struct {
+	fset    *token.FileSet
+	file    *ast.File
+	src     []byte
+	edits   []sourceEdit
+	aliases map[string]protogen.GoImportPath
+}
+
+// sourceEdit replaces the source between the offsets start and end with text.
+type sourceEdit struct {
+	start, end int
+	text       string
+}
+
+// parseGoSource parses the source code of a Go file.
+func parseGoSource(src []byte) (*goSource, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	return &goSource{fset: fset, file: file, src: src}, nil
+}
+
+// mustParseGoSource is like parseGoSource, but panics if the source cannot be parsed.
+// It is used for the output of generators, which is always valid Go code.
+func mustParseGoSource(src []byte) *goSource {
+	s, err := parseGoSource(src)
+	if err != nil {
+		panic(fmt.Sprintf("parsing generated code: %v", err))
+	}
+	return s
+}
+
+// offset returns the offset of pos in the source.
+func (s *goSource) offset(pos token.Pos) int {
+	return s.fset.Position(pos).Offset
+}
+
+// insert inserts the text at pos.
+func (s *goSource) insert(pos token.Pos, text string) {
+	s.edits = append(s.edits, sourceEdit{start: s.offset(pos), end: s.offset(pos), text: text})
+}
+
+// alias resolves the package name to the import path, although the package is
+// not imported by the source. It is used for packages, which are referenced in
+// type expressions the generator does not know about.
+func (s *goSource) alias(name string, importPath protogen.GoImportPath) {
+	if s.aliases == nil {
+		s.aliases = make(map[string]protogen.GoImportPath)
+	}
+	s.aliases[name] = importPath
+}
+
+// structType returns the struct type declared with the name, or nil.
+func (s *goSource) structType(name string) *ast.StructType {
+	for _, decl := range s.file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			if spec := spec.(*ast.TypeSpec); spec.Name.Name == name {
+				st, _ := spec.Type.(*ast.StructType)
+				return st
+			}
+		}
+	}
+	return nil
+}
+
+// funcDecl returns the function declared with the name, or nil.
+func (s *goSource) funcDecl(name string) *ast.FuncDecl {
+	for _, decl := range s.file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil && fn.Name.Name == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+// imports returns the import paths by the names used in the source.
+func (s *goSource) imports() map[string]protogen.GoImportPath {
+	imports := make(map[string]protogen.GoImportPath)
+	for _, spec := range s.file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = protogen.GoImportPath(importPath)
+	}
+	for name, importPath := range s.aliases {
+		imports[name] = importPath
+	}
+	return imports
+}
+
+// generate emits all declarations but the imports to the generated file.
+// Qualified identifiers are rewritten to the package names of the generated
+// file, identifiers of its own package are unqualified.
+func (s *goSource) generate(g *protogen.GeneratedFile) {
+	imports := s.imports()
+	edits := append([]sourceEdit(nil), s.edits...)
+
+	ast.Inspect(s.file, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		// Package names are not resolved by the parser, unlike local identifiers.
+		x, ok := sel.X.(*ast.Ident)
+		if !ok || x.Obj != nil {
+			return true
+		}
+		importPath, ok := imports[x.Name]
+		if !ok {
+			return true
+		}
+		qualified := g.QualifiedGoIdent(importPath.Ident(sel.Sel.Name))
+		edits = append(edits, sourceEdit{
+			start: s.offset(sel.Pos()),
+			end:   s.offset(sel.End()),
+			text:  qualified,
+		})
+		return false
+	})
+
+	// The declarations start after the imports, which drops the package clause
+	// and the header comment of the file.
+	start := s.offset(s.file.Name.End())
+	for _, decl := range s.file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			start = s.offset(gen.End())
+		}
+	}
+
+	sort.SliceStable(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+
+	var out []byte
+	pos := start
+	for _, e := range edits {
+		if e.start < pos {
+			continue
+		}
+		out = append(out, s.src[pos:e.start]...)
+		out = append(out, e.text...)
+		pos = e.end
+	}
+	out = append(out, s.src[pos:]...)
+
+	g.P(string(out))
+}
@@ -0,0 +1,88 @@
+package framework
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// generateScripts generates the constructors of the scripted client streams
+// for all bidirectional streaming methods of the service.
+// The generated code does not depend on the mocking framework.
+func generateScripts(g *protogen.GeneratedFile, service *protogen.Service, opts Options) {
+	for _, method := range service.Methods {
+		if !method.Desc.IsStreamingClient() || !method.Desc.IsStreamingServer() {
+			continue
+		}
+
+		scriptType := g.QualifiedGoIdent(grpcmockPackage.Ident("Script")) + "[" +
+			g.QualifiedGoIdent(method.Input.GoIdent) + ", " + g.QualifiedGoIdent(method.Output.GoIdent) + "]"
+
+		if service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated() {
+			g.P(deprecationComment)
+		}
+		newScript := g.QualifiedGoIdent(grpcmockPackage.Ident("NewScript")) + "[" +
+			g.QualifiedGoIdent(method.Input.GoIdent) + ", " + g.QualifiedGoIdent(method.Output.GoIdent) + "](\"" + fullMethodName(method) + "\")"
+		newFunc := "New" + method.Parent.GoName + "_" + method.GoName + ClientSuffix + "Script"
+		g.P("func ", newFunc, "() *", scriptType, " {")
+		g.P("return ", newScript)
+		g.P("}")
+		g.P()
+		if !opts.TestingTB {
+			continue
+		}
+
+		if service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated() {
+			g.P(deprecationComment)
+		}
+		g.P("func ", newFunc, "T(t ", testingPackage.Ident("TB"), ") *", scriptType, " {")
+		g.P("s := ", newFunc, "()")
+		g.P("t.Cleanup(func() { s.AssertExpectations(t) })")
+		g.P("return s")
+		g.P("}")
+		g.P()
+	}
+}
+
+// fullMethodName returns the full name of the method as used by gRPC, for example:
+// `/routeguide.RouteGuide/RouteChat`.
+func fullMethodName(method *protogen.Method) string {
+	return "/" + string(method.Parent.Desc.FullName()) + "/" + string(method.Desc.Name())
+}
+
+// streamMock returns the generic mock of the framework package pkg for the client
+// or server stream of the method, for example:
+// `testifymock.BidiStreamingClient[RouteNote, RouteNote]`.
+func streamMock(g *protogen.GeneratedFile, method *protogen.Method, pkg protogen.GoImportPath, suffix string) string {
+	kind := "BidiStreaming"
+	typeArgs := "[" + g.QualifiedGoIdent(method.Input.GoIdent) + ", " + g.QualifiedGoIdent(method.Output.GoIdent) + "]"
+	switch {
+	case !method.Desc.IsStreamingClient():
+		kind, typeArgs = "ServerStreaming", "["+g.QualifiedGoIdent(method.Output.GoIdent)+"]"
+	case !method.Desc.IsStreamingServer():
+		kind = "ClientStreaming"
+	}
+	return g.QualifiedGoIdent(pkg.Ident(kind+suffix)) + typeArgs
+}
+
+// generatePipes generates the constructors of the connected client and server
+// streams for all streaming methods of the service.
+// The generated code does not depend on the mocking framework.
+func generatePipes(g *protogen.GeneratedFile, service *protogen.Service) {
+	for _, method := range service.Methods {
+		if !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer() {
+			continue
+		}
+
+		typeArgs := "[" + g.QualifiedGoIdent(method.Input.GoIdent) + ", " + g.QualifiedGoIdent(method.Output.GoIdent) + "]"
+		clientType := "*" + g.QualifiedGoIdent(grpcmockPackage.Ident("ClientStream")) + typeArgs
+		serverType := "*" + g.QualifiedGoIdent(grpcmockPackage.Ident("ServerStream")) + typeArgs
+
+		if service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated() {
+			g.P(deprecationComment)
+		}
+		g.P("func New", method.Parent.GoName, "_", method.GoName, "Pipe(ctx ", contextPackage.Ident("Context"), ") (", clientType, ", ", serverType, ") {")
+		g.P("return ", grpcmockPackage.Ident("NewPipe"), typeArgs, "(ctx, \"", fullMethodName(method), "\")")
+		g.P("}")
+		g.P()
+	}
+}
@@ -0,0 +1,122 @@
+package framework
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const testifySuitePackage = protogen.GoImportPath("github.com/stretchr/testify/suite")
+
+// suiteMock is a mock created by the suite of a service.
+type suiteMock struct {
+	field    string
+	typeName string
+}
+
+// generateSuite generates a testify suite for the service, which creates fresh
+// mocks in SetupTest and asserts their expectations in TearDownTest.
+func (tm *testifyMocker) generateSuite(g *protogen.GeneratedFile, service *protogen.Service) {
+	prefix := tm.prefix(service)
+	suiteName := service.GoName + prefix + "Suite"
+
+	mocks := []suiteMock{
+		{ClientSuffix, prefix + service.GoName + ClientSuffix},
+		{ServerSuffix, prefix + service.GoName + ServerSuffix},
+	}
+	for _, method := range service.Methods {
+		if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+			mocks = append(mocks,
+				suiteMock{method.GoName + ClientSuffix, prefix + service.GoName + "_" + method.GoName + ClientSuffix},
+				suiteMock{method.GoName + ServerSuffix, prefix + service.GoName + "_" + method.GoName + ServerSuffix},
+			)
+		}
+	}
+
+	deprecated := service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated()
+
+	// Suite structure.
+	g.P("// ", suiteName, " is a testify suite providing fresh mocks of the ", service.GoName, " service for each test.")
+	g.P("// The client mock returns the stream mocks of the suite, when the streaming methods are stubbed.")
+	if deprecated {
+		g.P("//")
+		g.P(deprecationComment)
+	}
+	g.P("type ", suiteName, " struct {")
+	g.P(testifySuitePackage.Ident("Suite"))
+	g.P()
+	for _, m := range mocks {
+		g.P(m.field, " *", m.typeName)
+	}
+	g.P("}")
+	g.P()
+
+	// SetupTest creates the mocks.
+	g.P("func (s *", suiteName, ") SetupTest() {")
+	for _, m := range mocks {
+		if tm.opts.TestingTB {
+			g.P("s.", m.field, " = New", m.typeName, "T(s.T())")
+		} else {
+			g.P("s.", m.field, " = New", m.typeName, "()")
+			g.P(testifymockPackage.Ident("Test"), "(&s.", m.field, ".Mock, s.T())")
+		}
+	}
+	g.P("}")
+	g.P()
+
+	// TearDownTest asserts the expectations, unless the constructors already registered the assertions.
+	if !tm.opts.TestingTB {
+		g.P("func (s *", suiteName, ") TearDownTest() {")
+		for _, m := range mocks {
+			g.P("s.", m.field, ".AssertExpectations(s.T())")
+		}
+		g.P("}")
+		g.P()
+	}
+
+	// Stub helpers for the client.
+	for _, method := range service.Methods {
+		call := "*" + g.QualifiedGoIdent(testifyMockPackage.Ident("Call"))
+		anything := g.QualifiedGoIdent(testifyMockPackage.Ident("Anything"))
+
+		g.P("// Stub", method.GoName, " stubs the ", method.GoName, " method of the client mock with any context.")
+		switch {
+		case !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer():
+			g.P("func (s *", suiteName, ") Stub", method.GoName, "(in interface{}, out *", method.Output.GoIdent, ", err error) ", call, " {")
+			g.P("return s.", ClientSuffix, ".On", method.GoName, "(", anything, ", in).Return(out, err)")
+		case !method.Desc.IsStreamingClient():
+			g.P("// It returns the ", method.GoName+ClientSuffix, " stream mock of the suite.")
+			g.P("func (s *", suiteName, ") Stub", method.GoName, "(in interface{}) ", call, " {")
+			g.P("return s.", ClientSuffix, ".On", method.GoName, "(", anything, ", in).Return(s.", method.GoName+ClientSuffix, ", nil)")
+		default:
+			g.P("// It returns the ", method.GoName+ClientSuffix, " stream mock of the suite.")
+			g.P("func (s *", suiteName, ") Stub", method.GoName, "() ", call, " {")
+			g.P("return s.", ClientSuffix, ".On", method.GoName, "(", anything, ").Return(s.", method.GoName+ClientSuffix, ", nil)")
+		}
+		g.P("}")
+		g.P()
+	}
+
+	// Stub helpers for the server. The streams of the server are created by the
+	// caller of the server, so they are matched with any stream.
+	for _, method := range service.Methods {
+		call := "*" + g.QualifiedGoIdent(testifyMockPackage.Ident("Call"))
+		anything := g.QualifiedGoIdent(testifyMockPackage.Ident("Anything"))
+
+		switch {
+		case !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer():
+			g.P("// StubServer", method.GoName, " stubs the ", method.GoName, " method of the server mock with any context.")
+			g.P("func (s *", suiteName, ") StubServer", method.GoName, "(in interface{}, out *", method.Output.GoIdent, ", err error) ", call, " {")
+			g.P("return s.", ServerSuffix, ".On", method.GoName, "(", anything, ", in).Return(out, err)")
+		case !method.Desc.IsStreamingClient():
+			g.P("// StubServer", method.GoName, " stubs the ", method.GoName, " method of the server mock with any stream.")
+			g.P("func (s *", suiteName, ") StubServer", method.GoName, "(in interface{}, err error) ", call, " {")
+			g.P("return s.", ServerSuffix, ".On", method.GoName, "(in, ", anything, ").Return(err)")
+		default:
+			g.P("// StubServer", method.GoName, " stubs the ", method.GoName, " method of the server mock with any stream.")
+			g.P("func (s *", suiteName, ") StubServer", method.GoName, "(err error) ", call, " {")
+			g.P("return s.", ServerSuffix, ".On", method.GoName, "(", anything, ").Return(err)")
+		}
+		g.P("}")
+		g.P()
+	}
+}
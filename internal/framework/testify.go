@@ -2,6 +2,7 @@
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	_ "github.com/stretchr/testify/mock" // needed for version information in the import path
@@ -15,23 +16,60 @@
 const (
 	deprecationComment = "// Deprecated: Do not use."
 
-	grpcMetaPackage    = protogen.GoImportPath("google.golang.org/grpc/metadata")
 	testifyMockPackage = protogen.GoImportPath("github.com/stretchr/testify/mock")
 )
 
-type testifyMocker struct{}
+type testifyMocker struct {
+	opts   Options
+	config *generator.Config
+}
 
-func NewTestifyMocker() generator.Mocker {
-	return &testifyMocker{}
+func NewTestifyMocker(opts Options) generator.Mocker {
+	return &testifyMocker{opts: opts}
 }
 
 func (tm *testifyMocker) Name() string {
 	return "testify"
 }
 
+// WithConfig returns a copy of the mocker, whose services and methods are
+// lenient as configured.
+func (tm *testifyMocker) WithConfig(config *generator.Config) generator.Mocker {
+	return &testifyMocker{opts: tm.opts, config: config}
+}
+
+func (tm *testifyMocker) Output(file *protogen.File) generator.Output {
+	return targetOutput(file, tm.opts.Target)
+}
+
 func (tm *testifyMocker) Mock(g *protogen.GeneratedFile, file *protogen.File) {
+	tm.MockPackage(g, []*protogen.File{file})
+}
+
+func (tm *testifyMocker) MockPackage(g *protogen.GeneratedFile, files []*protogen.File) {
+	decls := make(declarations)
+	for _, file := range files {
+		switch tm.opts.Target {
+		case TargetConnect:
+			tm.mockConnect(g, file)
+		case TargetTwirp:
+			tm.mockTwirp(g, file)
+		default:
+			tm.mockGRPC(g, file)
+		}
+
+		generateCaptors(g, file, testifymockPackage)
+		generateFieldMatchers(g, file, func(*protogen.Message) string { return "interface{}" }, testifyMockPackage.Ident("MatchedBy"))
+		if tm.opts.Gomega {
+			generateGomegaMatchers(g, file, decls, testifymockPackage)
+		}
+	}
+}
+
+func (tm *testifyMocker) mockGRPC(g *protogen.GeneratedFile, file *protogen.File) {
 	for _, msg := range file.Messages {
 		tm.generateMatcher(g, file.GoPackageName, msg.GoIdent.GoName)
+		tm.generateEqMatcher(g, msg)
 	}
 
 	for _, service := range file.Services {
@@ -42,7 +80,15 @@ func (tm *testifyMocker) Mock(g *protogen.GeneratedFile, file *protogen.File) {
 			}
 		}
 
-		tm.generateService(g, service)
+		tm.generateService(g, file, service)
+		generateScripts(g, service, tm.opts)
+		generatePipes(g, service)
+		generateInterceptors(g, file, service)
+		generateAssertions(g, file, service, tm.prefix(service))
+
+		if tm.opts.Suite {
+			tm.generateSuite(g, service)
+		}
 	}
 }
 
@@ -53,14 +99,25 @@ func (tm *testifyMocker) generateMatcher(g *protogen.GeneratedFile, pkg protogen
 	g.P()
 }
 
-func (tm *testifyMocker) generateService(g *protogen.GeneratedFile, service *protogen.Service) {
-	clientName := MockPrefix + service.GoName + ClientSuffix
+func (tm *testifyMocker) generateEqMatcher(g *protogen.GeneratedFile, msg *protogen.Message) {
+	g.P("func Eq", msg.GoIdent.GoName, "(v *", msg.GoIdent, ") interface{} {")
+	g.P("return ", testifymockPackage.Ident("EqMessage"), "(v)")
+	g.P("}")
+	g.P()
+}
+
+func (tm *testifyMocker) generateService(g *protogen.GeneratedFile, file *protogen.File, service *protogen.Service) {
+	clientName := tm.prefix(service) + service.GoName + ClientSuffix
 
-	// Client structure.
-	tm.generateStruct(g, clientName)
+	// Client structure, which records the unstubbed calls of lenient methods.
+	var unstubbed []protogen.GoIdent
+	if tm.anyLenient(service) {
+		unstubbed = append(unstubbed, testifymockPackage.Ident("Unstubbed"))
+	}
+	tm.generateStruct(g, service, clientName, unstubbed...)
 
 	// NewClient factory.
-	tm.generateNewFunc(g, service, clientName)
+	tm.generateNewFunc(g, service, clientName, true, tm.lenientService(service))
 
 	// Client method implementations.
 	for _, method := range service.Methods {
@@ -70,13 +127,13 @@ func (tm *testifyMocker) generateService(g *protogen.GeneratedFile, service *pro
 		}
 	}
 
-	serverName := MockPrefix + service.GoName + ServerSuffix
+	serverName := tm.prefix(service) + service.GoName + ServerSuffix
 
-	// Server structure.
-	tm.generateStruct(g, serverName)
+	// Server structure, which embeds the unimplemented server to satisfy the server interface.
+	tm.generateStruct(g, service, serverName, append([]protogen.GoIdent{unimplementedServer(file, service)}, unstubbed...)...)
 
 	// NewServer factory.
-	tm.generateNewFunc(g, service, serverName)
+	tm.generateNewFunc(g, service, serverName, true, tm.lenientService(service))
 
 	// Server method implementations.
 	for _, method := range service.Methods {
@@ -87,164 +144,144 @@ func (tm *testifyMocker) generateService(g *protogen.GeneratedFile, service *pro
 	}
 }
 
-func (tm *testifyMocker) generateStruct(g *protogen.GeneratedFile, typeName string) {
+func (tm *testifyMocker) generateStruct(g *protogen.GeneratedFile, service *protogen.Service, typeName string, embedded ...protogen.GoIdent) {
 	g.P("type ", typeName, " struct {")
 	g.P(g.QualifiedGoIdent(testifyMockPackage.Ident("Mock")))
+	for _, ident := range embedded {
+		g.P(g.QualifiedGoIdent(ident))
+	}
+	g.P("expectations ", testifymockPackage.Ident("Expectations"))
 	g.P("}")
 	g.P()
-}
 
-func (tm *testifyMocker) generateNewFunc(g *protogen.GeneratedFile, service *protogen.Service, typeName string) {
-	if service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated() {
-		g.P(deprecationComment)
+	// On shadows mock.Mock.On, so Called knows all expectations of the mock.
+	// A method named On of the service shadows it instead.
+	for _, method := range service.Methods {
+		if method.GoName == "On" {
+			return
+		}
 	}
-	g.P("func New", typeName, " (", ") *", typeName, " {")
-	g.P("return &", typeName, "{}")
+	g.P("func (m *", typeName, ") On(method string, args ...interface{}) *", testifyMockPackage.Ident("Call"), " {")
+	g.P("return ", testifymockPackage.Ident("On"), "(&m.Mock, &m.expectations, method, args...)")
 	g.P("}")
 	g.P()
 }
 
-func (tm *testifyMocker) generateClientStreamHandler(g *protogen.GeneratedFile, method *protogen.Method) {
-	clientStreamHandler := MockPrefix + method.Parent.GoName + "_" + method.GoName + ClientSuffix
-	tm.generateStruct(g, clientStreamHandler)
-
-	tm.generateNewFunc(g, method.Parent, clientStreamHandler)
-
-	g.P("func (x *", clientStreamHandler, ") Header() (", g.QualifiedGoIdent(grpcMetaPackage.Ident("MD")), ", error) {")
-	g.P("args := x.Called()")
-	g.P("return args.Get(0).(", g.QualifiedGoIdent(grpcMetaPackage.Ident("MD")), "), args.Error(1)")
-	g.P("}")
-	g.P()
-
-	g.P("func (x *", clientStreamHandler, ") Trailer() ", g.QualifiedGoIdent(grpcMetaPackage.Ident("MD")), " {")
-	g.P("args := x.Called()")
-	g.P("return args.Get(0).(", g.QualifiedGoIdent(grpcMetaPackage.Ident("MD")), ")")
-	g.P("}")
-	g.P()
-
-	g.P("func (x *", clientStreamHandler, ") CloseSend() error {")
-	g.P("args := x.Called()")
-	g.P("return args.Error(0)")
-	g.P("}")
-	g.P()
-
-	g.P("func (x *", clientStreamHandler, ") Context() ", g.QualifiedGoIdent(contextPackage.Ident("Context")), " {")
-	g.P("args := x.Called()")
-	g.P("return args.Get(0).(", g.QualifiedGoIdent(contextPackage.Ident("Context")), ")")
-	g.P("}")
-	g.P()
-
-	g.P("func (x *", clientStreamHandler, ") SendMsg(m interface{}) error {")
-	g.P("args := x.Called(m)")
-	g.P("return args.Error(0)")
-	g.P("}")
-	g.P()
-
-	g.P("func (x *", clientStreamHandler, ") RecvMsg(m interface{}) error {")
-	g.P("args := x.Called(m)")
-	g.P("return args.Error(0)")
-	g.P("}")
-	g.P()
-
-	if method.Desc.IsStreamingClient() {
-		g.P("func (x *", clientStreamHandler, ") Send(m *", g.QualifiedGoIdent(method.Input.GoIdent), ") error {")
-		g.P("args := x.Called(m)")
-		g.P("return args.Error(0)")
-		g.P("}")
-		g.P()
+// lenient reports whether the calls of the method without a matching expectation
+// return fallbacks instead of panicking.
+func (tm *testifyMocker) lenient(method *protogen.Method) bool {
+	return tm.opts.Target == TargetGRPC && tm.config.Lenient(method, tm.opts.Lenient)
+}
 
-		g.P("func (x *", clientStreamHandler, ") OnSend(m interface{}) *", g.QualifiedGoIdent(testifyMockPackage.Ident("Call")), " {")
-		g.P("return x.On(\"Send\", m)")
-		g.P("}")
-		g.P()
+// prefix returns the prefix of the names of the mocks of the service, which is
+// only configured for the mocks of gRPC services.
+func (tm *testifyMocker) prefix(service *protogen.Service) string {
+	if tm.opts.Target != TargetGRPC {
+		return MockPrefix
 	}
+	return tm.config.Prefix(service, MockPrefix)
+}
 
-	methodName := "Recv"
-	if !method.Desc.IsStreamingServer() {
-		methodName = "CloseAndRecv"
+// lenientService reports whether all methods of the service are lenient.
+func (tm *testifyMocker) lenientService(service *protogen.Service) bool {
+	for _, method := range service.Methods {
+		if !tm.lenient(method) {
+			return false
+		}
 	}
-
-	g.P("func (x *", clientStreamHandler, ") ", methodName, "() ", "(*", g.QualifiedGoIdent(method.Output.GoIdent), ", error) {")
-	g.P("args := x.Called()")
-	g.P("return args.Get(0).(*", g.QualifiedGoIdent(method.Output.GoIdent), "), args.Error(1)")
-	g.P("}")
-	g.P()
-
-	g.P("func (x *", clientStreamHandler, ") On", methodName, "() *", g.QualifiedGoIdent(testifyMockPackage.Ident("Call")), " {")
-	g.P("return x.On(\"", methodName, "\")")
-	g.P("}")
-	g.P()
+	return len(service.Methods) > 0
 }
 
-func (tm *testifyMocker) generateServerStreamHandler(g *protogen.GeneratedFile, method *protogen.Method) {
-	serverStreamHandler := MockPrefix + method.Parent.GoName + "_" + method.GoName + ServerSuffix
-	tm.generateStruct(g, serverStreamHandler)
-
-	tm.generateNewFunc(g, method.Parent, serverStreamHandler)
-
-	g.P("func (x *", serverStreamHandler, ") SetHeader(md ", g.QualifiedGoIdent(grpcMetaPackage.Ident("MD")), ") error {")
-	g.P("args := x.Called(md)")
-	g.P("return args.Error(0)")
-	g.P("}")
-	g.P()
-
-	g.P("func (x *", serverStreamHandler, ") SendHeader(md ", g.QualifiedGoIdent(grpcMetaPackage.Ident("MD")), ") error {")
-	g.P("args := x.Called(md)")
-	g.P("return args.Error(0)")
-	g.P("}")
-	g.P()
+// anyLenient reports whether any method of the service is lenient.
+func (tm *testifyMocker) anyLenient(service *protogen.Service) bool {
+	for _, method := range service.Methods {
+		if tm.lenient(method) {
+			return true
+		}
+	}
+	return false
+}
 
-	g.P("func (x *", serverStreamHandler, ") SetTrailer(md ", g.QualifiedGoIdent(grpcMetaPackage.Ident("MD")), ") {")
-	g.P("_ = x.Called(md)")
-	g.P("}")
-	g.P()
+// called returns the call of the method on the mock recv. Failed calls report
+// the diffs of the messages to the closest expectation. The calls of lenient
+// mocks without a matching expectation return the results of the fallback, which
+// is the name of a fallback function of the testifymock package.
+func (tm *testifyMocker) called(g *protogen.GeneratedFile, recv string, method *model.Method, args []string, fallback string, results ...string) string {
+	if !tm.lenient(method.Method) {
+		return g.QualifiedGoIdent(testifymockPackage.Ident("Called")) + "(&" + recv + ".Mock, &" + recv + ".expectations, " +
+			strings.Join(append([]string{strconv.Quote(method.GoName)}, args...), ", ") + ")"
+	}
+	return g.QualifiedGoIdent(testifymockPackage.Ident("LenientCalled")) + "(&" + recv + ".Mock, &" + recv + ".expectations, &" + recv + ".Unstubbed, " +
+		g.QualifiedGoIdent(testifymockPackage.Ident(fallback)) + "(" + strings.Join(results, ", ") + "), " +
+		strings.Join(append([]string{strconv.Quote(method.GoName)}, args...), ", ") + ")"
+}
 
-	g.P("func (x *", serverStreamHandler, ") Context() ", g.QualifiedGoIdent(contextPackage.Ident("Context")), " {")
-	g.P("args := x.Called()")
-	g.P("return args.Get(0).(", g.QualifiedGoIdent(contextPackage.Ident("Context")), ")")
+// generateNewFunc generates the constructor of the mock. The mocks of the service
+// itself are registered for the coverage report, but not the mocks of the streams.
+// The mocks of the streams are lenient like their methods, if lenient is set.
+func (tm *testifyMocker) generateNewFunc(g *protogen.GeneratedFile, service *protogen.Service, typeName string, coverage, lenient bool) {
+	deprecated := service.Desc.Options().(*descriptorpb.ServiceOptions).GetDeprecated()
+	if deprecated {
+		g.P(deprecationComment)
+	}
+	g.P("func New", typeName, " (", ") *", typeName, " {")
+	switch {
+	case coverage:
+		g.P("m := &", typeName, "{}")
+		g.P(trackCoverage(g, testifymockPackage, service, "&m.expectations"))
+		g.P("return m")
+	case lenient:
+		g.P("m := &", typeName, "{}")
+		g.P("m.SetLenient(true)")
+		g.P("return m")
+	default:
+		g.P("return &", typeName, "{}")
+	}
 	g.P("}")
 	g.P()
+	if !tm.opts.TestingTB {
+		return
+	}
 
-	g.P("func (x *", serverStreamHandler, ") SendMsg(m interface{}) error {")
-	g.P("args := x.Called(m)")
-	g.P("return args.Error(0)")
+	// The mock reports unexpected calls to t instead of panicking
+	// and asserts its expectations, when the test finishes.
+	// Lenient mocks do not report unexpected calls.
+	if deprecated {
+		g.P(deprecationComment)
+	}
+	g.P("func New", typeName, "T(t ", testingPackage.Ident("TB"), ") *", typeName, " {")
+	g.P("m := New", typeName, "()")
+	if !lenient {
+		g.P(testifymockPackage.Ident("Test"), "(&m.Mock, t)")
+	}
+	g.P("t.Cleanup(func() { m.AssertExpectations(t) })")
+	g.P("return m")
 	g.P("}")
 	g.P()
+}
 
-	g.P("func (x *", serverStreamHandler, ") RecvMsg(m interface{}) error {")
-	g.P("args := x.Called(m)")
-	g.P("return args.Error(0)")
+// generateClientStreamHandler generates the mock of the client stream of the
+// method, which embeds the generic mock of the testifymock package.
+func (tm *testifyMocker) generateClientStreamHandler(g *protogen.GeneratedFile, method *protogen.Method) {
+	clientStreamHandler := tm.prefix(method.Parent) + method.Parent.GoName + "_" + method.GoName + ClientSuffix
+	g.P("type ", clientStreamHandler, " struct {")
+	g.P(streamMock(g, method, testifymockPackage, ClientSuffix))
 	g.P("}")
 	g.P()
 
-	if method.Desc.IsStreamingClient() {
-		g.P("func (x *", serverStreamHandler, ") Recv() ", "(*", g.QualifiedGoIdent(method.Input.GoIdent), ", error) {")
-		g.P("args := x.Called()")
-		g.P("return args.Get(0).(*", g.QualifiedGoIdent(method.Input.GoIdent), "), args.Error(1)")
-		g.P("}")
-		g.P()
-
-		g.P("func (x *", serverStreamHandler, ") OnRecv() *", g.QualifiedGoIdent(testifyMockPackage.Ident("Call")), " {")
-		g.P("return x.On(\"Recv\")")
-		g.P("}")
-		g.P()
-	}
-
-	methodName := "Send"
-	if !method.Desc.IsStreamingServer() {
-		methodName = "SendAndClose"
-	}
+	tm.generateNewFunc(g, method.Parent, clientStreamHandler, false, tm.lenient(method))
+}
 
-	g.P("func (x *", serverStreamHandler, ") ", methodName, "(m *", g.QualifiedGoIdent(method.Output.GoIdent), ") error {")
-	g.P("args := x.Called(m)")
-	g.P("return args.Error(0)")
+// generateServerStreamHandler generates the mock of the server stream of the
+// method, which embeds the generic mock of the testifymock package.
+func (tm *testifyMocker) generateServerStreamHandler(g *protogen.GeneratedFile, method *protogen.Method) {
+	serverStreamHandler := tm.prefix(method.Parent) + method.Parent.GoName + "_" + method.GoName + ServerSuffix
+	g.P("type ", serverStreamHandler, " struct {")
+	g.P(streamMock(g, method, testifymockPackage, ServerSuffix))
 	g.P("}")
 	g.P()
 
-	g.P("func (x *", serverStreamHandler, ") On", methodName, "(m interface{}) *", g.QualifiedGoIdent(testifyMockPackage.Ident("Call")), " {")
-	g.P("return x.On(\"", methodName, "\", m)")
-	g.P("}")
-	g.P()
+	tm.generateNewFunc(g, method.Parent, serverStreamHandler, false, tm.lenient(method))
 }
 
 func (tm *testifyMocker) generateMethodDefinitions(g *protogen.GeneratedFile, method *model.Method) {
@@ -263,9 +300,9 @@ func (tm *testifyMocker) generateMethodDefinitions(g *protogen.GeneratedFile, me
 		g.P("for _, ", lastArg.Name, "1 := range ", lastArg.Name, " {")
 		g.P(lastArg.Name, "0 = append(", lastArg.Name, "0, ", lastArg.Name, "1)")
 		g.P("}")
-		g.P("args := ", method.Receiver.Name, ".Called(", lastArg.Name, "0...)")
+		g.P("args := ", tm.calledMethod(g, method, lastArg.Name+"0..."))
 	} else {
-		g.P("args := ", method.Receiver.Name, ".Called(", strings.Join(args, ", "), ")")
+		g.P("args := ", tm.calledMethod(g, method, args...))
 	}
 
 	if len(method.Return) > 0 {
@@ -304,16 +341,36 @@ func (tm *testifyMocker) generateMethodDefinitions(g *protogen.GeneratedFile, me
 
 	g.P(method, "{")
 	if lastArg.Type.IsVariadic() {
-		g.P("return ", method.Receiver.Name, ".On(\"", methodName, "\", append([]interface{}{", strings.Join(args[:len(args)-1], ", "), "},", lastArg.Name, "...)...)")
+		g.P("return ", testifymockPackage.Ident("On"), "(&", method.Receiver.Name, ".Mock, &", method.Receiver.Name, ".expectations, \"", methodName, "\", append([]interface{}{", strings.Join(args[:len(args)-1], ", "), "},", lastArg.Name, "...)...)")
 	} else {
-		g.P("return ", method.Receiver.Name, ".On(\"", methodName, "\", ", strings.Join(args, ", "), ")")
+		g.P("return ", testifymockPackage.Ident("On"), "(&", method.Receiver.Name, ".Mock, &", method.Receiver.Name, ".expectations, \"", methodName, "\", ", strings.Join(args, ", "), ")")
 	}
 	g.P("}")
 	g.P()
 }
 
+// calledMethod returns the call of the RPC method on the mock. The unstubbed calls
+// of lenient mocks return codes.Unimplemented for unary methods and finished
+// streams for streaming methods, so the client receives io.EOF.
+func (tm *testifyMocker) calledMethod(g *protogen.GeneratedFile, method *model.Method, args ...string) string {
+	recv := method.Receiver.Name
+	switch {
+	case !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer():
+		results := []string{strconv.Quote(fullMethodName(method.Method))}
+		for _, r := range method.Return[:len(method.Return)-1] {
+			results = append(results, "("+string(r)+")(nil)")
+		}
+		return tm.called(g, recv, method, args, "Unimplemented", results...)
+	case len(method.Return) > 1:
+		fallback := "EndOfStream[" + g.QualifiedGoIdent(method.Input.GoIdent) + ", " + g.QualifiedGoIdent(method.Output.GoIdent) + "]"
+		return tm.called(g, recv, method, args, fallback, "ctx", strconv.Quote(fullMethodName(method.Method)))
+	default:
+		return tm.called(g, recv, method, args, "Returns", "nil")
+	}
+}
+
 func (tm *testifyMocker) clientMethod(g *protogen.GeneratedFile, method *protogen.Method) *model.Method {
-	m := model.NewMethod(method, model.Receiver{Name: "c", Type: "*" + MockPrefix + method.Parent.GoName + ClientSuffix})
+	m := model.NewMethod(method, model.Receiver{Name: "c", Type: "*" + tm.prefix(method.Parent) + method.Parent.GoName + ClientSuffix})
 	m.AddArgument("ctx", g.QualifiedGoIdent(contextPackage.Ident("Context")))
 	if !method.Desc.IsStreamingClient() {
 		m.AddArgument("in", "*"+g.QualifiedGoIdent(method.Input.GoIdent))
@@ -332,7 +389,7 @@ func (tm *testifyMocker) clientMethod(g *protogen.GeneratedFile, method *protoge
 }
 
 func (tm *testifyMocker) serverMethod(g *protogen.GeneratedFile, method *protogen.Method) *model.Method {
-	m := model.NewMethod(method, model.Receiver{Name: "s", Type: "*" + MockPrefix + method.Parent.GoName + ServerSuffix})
+	m := model.NewMethod(method, model.Receiver{Name: "s", Type: "*" + tm.prefix(method.Parent) + method.Parent.GoName + ServerSuffix})
 	if !method.Desc.IsStreamingClient() && !method.Desc.IsStreamingServer() {
 		m.AddArgument("ctx", g.QualifiedGoIdent(contextPackage.Ident("Context")))
 		m.AddReturn("*" + g.QualifiedGoIdent(method.Output.GoIdent))
@@ -351,5 +408,5 @@ func (tm *testifyMocker) serverMethod(g *protogen.GeneratedFile, method *protoge
 }
 
 func init() {
-	setMocker("testify", NewTestifyMocker)
+	setMocker("testify", NewTestifyMocker, TargetGRPC, TargetConnect, TargetTwirp)
 }
@@ -0,0 +1,107 @@
+package framework
+
+import (
+	"github.com/petergtz/pegomock/mockgen"
+	"github.com/petergtz/pegomock/model"
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/lovoo/protoc-gen-go-grpcmock/internal/generator"
+	mockmodel "github.com/lovoo/protoc-gen-go-grpcmock/internal/model"
+)
+
+// TwirpFilenameSuffix is the suffix of the files containing the twirp mocks.
+const TwirpFilenameSuffix = "_twirp_mock.pb.go"
+
+// twirpOutput returns the output of the twirp mocks, which are generated to
+// the package of the code generated by protoc-gen-twirp.
+func twirpOutput(file *protogen.File) generator.Output {
+	return generator.Output{
+		Filename:    file.GeneratedFilenamePrefix + TwirpFilenameSuffix,
+		PackageName: file.GoPackageName,
+		ImportPath:  file.GoImportPath,
+	}
+}
+
+// generateTwirpAssertions generates a compile-time assertion, that the twirp
+// mock implements the interface generated by protoc-gen-twirp.
+func generateTwirpAssertions(g *protogen.GeneratedFile, file *protogen.File, service *protogen.Service) {
+	g.P("var _ ", file.GoImportPath.Ident(service.GoName), " = (*", MockPrefix, service.GoName, ")(nil)")
+	g.P()
+}
+
+func (tm *testifyMocker) mockTwirp(g *protogen.GeneratedFile, file *protogen.File) {
+	for _, msg := range file.Messages {
+		tm.generateMatcher(g, file.GoPackageName, msg.GoIdent.GoName)
+		tm.generateEqMatcher(g, msg)
+	}
+
+	for _, service := range file.Services {
+		// Twirp generates a single interface per service, which is implemented
+		// by the client and the server, so a single mock is generated.
+		typeName := MockPrefix + service.GoName
+		tm.generateStruct(g, service, typeName)
+		tm.generateNewFunc(g, service, typeName, true, tm.lenientService(service))
+		for _, method := range service.Methods {
+			tm.generateMethodDefinitions(g, tm.twirpMethod(g, method))
+		}
+
+		generateTwirpAssertions(g, file, service)
+	}
+}
+
+// twirpMethod returns the method of the twirp interface. Twirp does not support
+// streaming, so all methods are generated as unary methods.
+func (tm *testifyMocker) twirpMethod(g *protogen.GeneratedFile, method *protogen.Method) *mockmodel.Method {
+	m := mockmodel.NewMethod(method, mockmodel.Receiver{Name: "m", Type: "*" + MockPrefix + method.Parent.GoName})
+	m.AddArgument("ctx", g.QualifiedGoIdent(contextPackage.Ident("Context")))
+	m.AddArgument("in", "*"+g.QualifiedGoIdent(method.Input.GoIdent))
+	m.AddReturn("*" + g.QualifiedGoIdent(method.Output.GoIdent))
+	m.AddReturn("error")
+	return m
+}
+
+func (pm *pegomockMocker) mockTwirp(g *protogen.GeneratedFile, file *protogen.File, decls declarations) {
+	matchers := make(argMatchers)
+
+	for _, service := range file.Services {
+		pkg := string(file.GoPackageName)
+
+		interfaces := []*model.Interface{
+			{
+				Name:    service.GoName, // Pegomock automatically adds the `Mock` prefix
+				Methods: mapSlice(service.Methods, pm.twirpMethod),
+			},
+		}
+
+		data, _ := mockgen.GenerateOutput(&model.Package{Name: pkg, Interfaces: interfaces},
+			file.Desc.Path(), "", pkg, string(file.GoImportPath))
+		matchers.add(interfaces...)
+
+		output := mustParseGoSource(data)
+		pm.withCoverage(g, output, service, service.GoName)
+		output.generate(g)
+		if pm.opts.TestingTB {
+			pm.generateTestingTB(g, interfaces)
+		}
+
+		generateTwirpAssertions(g, file, service)
+	}
+
+	pm.generateMatchers(g, matchers, decls)
+}
+
+// twirpMethod returns the method of the twirp interface. Twirp does not support
+// streaming, so all methods are generated as unary methods.
+func (pm *pegomockMocker) twirpMethod(method *protogen.Method) *model.Method {
+	return &model.Method{
+		Name: method.GoName,
+		In: []*model.Parameter{
+			{Name: "ctx", Type: pm.qualifiedGoIdent(contextPackage.Ident("Context"))},
+			{Name: "in", Type: &model.PointerType{Type: pm.qualifiedGoIdent(method.Input.GoIdent)}},
+		},
+		Out: []*model.Parameter{
+			{Type: &model.PointerType{Type: pm.qualifiedGoIdent(method.Output.GoIdent)}},
+			{Type: model.PredeclaredType("error")},
+		},
+	}
+}
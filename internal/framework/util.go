@@ -1,19 +1,16 @@
 package framework
 
-import (
-	"strings"
-)
+// declarations are the names of the declarations generated to a file, which may
+// be needed by the mocks of several .proto files, like the matchers of a type.
+type declarations map[string]bool
 
-func substringAfter(value, sep string) string {
-	pos := strings.Index(value, sep)
-	if pos == -1 {
-		return ""
+// declare marks the name as declared and reports whether it was not declared before.
+func (d declarations) declare(name string) bool {
+	if d[name] {
+		return false
 	}
-	start := pos + len(sep)
-	if start >= len(value) {
-		return ""
-	}
-	return value[start:]
+	d[name] = true
+	return true
 }
 
 func mapSlice[T any, S any](a []T, f func(T) S) []S {
@@ -0,0 +1,47 @@
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// CheckFile compares the content of the generated file with the file of the same
+// name in dir. It returns an error containing a diff, if the file is missing or
+// stale. The generated file is skipped, so it is not written by protoc.
+func CheckFile(dir, filename string, g *protogen.GeneratedFile) error {
+	g.Skip()
+
+	want, err := g.Content()
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, filename)
+	got, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%s is missing", path)
+	} else if err != nil {
+		return err
+	}
+
+	if string(got) == string(want) {
+		return nil
+	}
+
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(got)),
+		B:        difflib.SplitLines(string(want)),
+		FromFile: path,
+		ToFile:   filename + " (generated)",
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%s is stale:\n%s", path, diff)
+}
@@ -0,0 +1,344 @@
+package generator
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+	"sort"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the configuration file of the plugin. The settings of the defaults
+// are overridden by the settings of the proto packages, the settings of the
+// packages by the settings of their services and the settings of the services
+// by the settings of their methods:
+//
+//	defaults:
+//	  lenient: true
+//	packages:
+//	  helloworld:
+//	    skip: true
+//	services:
+//	  routeguide.RouteGuide:
+//	    lenient: false
+//	    prefix: Stub
+//	methods:
+//	  routeguide.RouteGuide.GetFeature:
+//	    lenient: true
+//
+// The packages, services and methods are identified by their full proto names.
+type Config struct {
+	Defaults Settings            `yaml:"defaults"`
+	Packages map[string]Settings `yaml:"packages"`
+	Services map[string]Settings `yaml:"services"`
+	Methods  map[string]Settings `yaml:"methods"`
+}
+
+// Settings are the settings of the mocks. Unset settings are inherited.
+type Settings struct {
+	// Lenient overrides the lenient option of the mocks.
+	Lenient *bool `yaml:"lenient"`
+	// Skip skips the generation of the mocks of the packages and services. The
+	// methods cannot be skipped, since the mocks implement all methods of their interfaces.
+	Skip *bool `yaml:"skip"`
+	// Prefix replaces the prefix "Mock" of the names of the mocks of the
+	// packages and services and of their constructors, suites and examples.
+	// It cannot be set for methods, since the mocks of a service share it.
+	Prefix *string `yaml:"prefix"`
+}
+
+// The errors of the validation of a config.
+var (
+	// ErrInvalidName is the error of a name, which is not a full proto name.
+	ErrInvalidName = errors.New("not a full proto name")
+	// ErrInvalidMethodName is the error of a method name without a service.
+	ErrInvalidMethodName = errors.New("not the full name of a method like <package>.<Service>.<Method>")
+	// ErrSkippedMethod is the error of a skipped method.
+	ErrSkippedMethod = errors.New("methods cannot be skipped, since the mocks implement all methods of their interfaces")
+	// ErrMethodPrefix is the error of a prefix set for a method.
+	ErrMethodPrefix = errors.New("the prefix cannot be set for methods, since the mocks of a service share it")
+	// ErrInvalidPrefix is the error of a prefix, which is not an exported Go identifier.
+	ErrInvalidPrefix = errors.New("the prefix is not an exported Go identifier")
+	// ErrUnknownPackage is the error of a package, which is not declared in the proto files.
+	ErrUnknownPackage = errors.New("package not declared in the proto files")
+	// ErrUnknownService is the error of a service, which is not declared in the proto files.
+	ErrUnknownService = errors.New("service not declared in the proto files")
+	// ErrUnknownMethod is the error of a method, which is not declared in the proto files.
+	ErrUnknownMethod = errors.New("method not declared in the proto files")
+)
+
+// ConfigMocker is implemented by mockers, whose options are overridden per
+// service and method by a Config.
+type ConfigMocker interface {
+	Mocker
+	// WithConfig returns a copy of the mocker, which uses the config.
+	WithConfig(config *Config) Mocker
+}
+
+// LoadConfig reads and validates the config file. It returns nil, if name is empty.
+func LoadConfig(name string) (*Config, error) {
+	if name == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("protoc-gen-go-grpcmock: %w", err)
+	}
+	config, err := ParseConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("protoc-gen-go-grpcmock: config %s: %w", name, err)
+	}
+	return config, nil
+}
+
+// ParseConfig parses and validates the content of a config file.
+func ParseConfig(data []byte) (*Config, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+
+	config := new(Config)
+	if err := dec.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (c *Config) validate() error {
+	var errs []error
+	if err := c.Defaults.validatePrefix(); err != nil {
+		errs = append(errs, fmt.Errorf("defaults: %w", err))
+	}
+	for _, section := range []struct {
+		name     string
+		settings map[string]Settings
+	}{
+		{"packages", c.Packages},
+		{"services", c.Services},
+		{"methods", c.Methods},
+	} {
+		for _, name := range sortedNames(section.settings) {
+			fullName := protoreflect.FullName(name)
+			settings := section.settings[name]
+			var err error
+			switch {
+			case !fullName.IsValid():
+				err = ErrInvalidName
+			case section.name == "methods" && fullName.Parent() == "":
+				err = ErrInvalidMethodName
+			case section.name == "methods" && settings.Skip != nil:
+				err = ErrSkippedMethod
+			case section.name == "methods" && settings.Prefix != nil:
+				err = ErrMethodPrefix
+			default:
+				err = settings.validatePrefix()
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q: %w", section.name, name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// validatePrefix reports an error, if the prefix is set, but not an exported Go
+// identifier.
+func (s Settings) validatePrefix() error {
+	if s.Prefix != nil && (!token.IsIdentifier(*s.Prefix) || !token.IsExported(*s.Prefix)) {
+		return fmt.Errorf("%w: %q", ErrInvalidPrefix, *s.Prefix)
+	}
+	return nil
+}
+
+// Validate reports the packages, services and methods of the config, which are
+// not declared in the files, so a misspelled name does not silently leave the
+// mocks unchanged.
+func (c *Config) Validate(files []*protogen.File) error {
+	if c == nil {
+		return nil
+	}
+	packages, services, methods := make(map[string]bool), make(map[string]bool), make(map[string]bool)
+	for _, file := range files {
+		packages[string(file.Desc.Package())] = true
+		for _, service := range file.Services {
+			services[string(service.Desc.FullName())] = true
+			for _, method := range service.Methods {
+				methods[string(method.Desc.FullName())] = true
+			}
+		}
+	}
+
+	var errs []error
+	for _, section := range []struct {
+		name     string
+		settings map[string]Settings
+		declared map[string]bool
+		err      error
+	}{
+		{"packages", c.Packages, packages, ErrUnknownPackage},
+		{"services", c.Services, services, ErrUnknownService},
+		{"methods", c.Methods, methods, ErrUnknownMethod},
+	} {
+		for _, name := range sortedNames(section.settings) {
+			if !section.declared[name] {
+				errs = append(errs, fmt.Errorf("%s: %q: %w", section.name, name, section.err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// EnablesLenient reports whether any setting of the config enables lenient mocks.
+func (c *Config) EnablesLenient() bool {
+	if c == nil {
+		return false
+	}
+	enables := func(s Settings) bool { return s.Lenient != nil && *s.Lenient }
+	if enables(c.Defaults) {
+		return true
+	}
+	for _, settings := range []map[string]Settings{c.Packages, c.Services, c.Methods} {
+		for _, s := range settings {
+			if enables(s) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// SetsPrefix reports whether any setting of the config sets the prefix of the mocks.
+func (c *Config) SetsPrefix() bool {
+	if c == nil {
+		return false
+	}
+	if c.Defaults.Prefix != nil {
+		return true
+	}
+	for _, settings := range []map[string]Settings{c.Packages, c.Services} {
+		for _, s := range settings {
+			if s.Prefix != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sortedNames returns the names of the settings in sorted order.
+func sortedNames(settings map[string]Settings) []string {
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// service returns the settings of the service.
+func (c *Config) service(service *protogen.Service) Settings {
+	if c == nil {
+		return Settings{}
+	}
+	settings := c.Defaults
+	settings.override(c.Packages[string(service.Desc.ParentFile().Package())])
+	settings.override(c.Services[string(service.Desc.FullName())])
+	return settings
+}
+
+// Skip reports whether the mocks of the service are skipped.
+func (c *Config) Skip(service *protogen.Service) bool {
+	skip := c.service(service).Skip
+	return skip != nil && *skip
+}
+
+// Prefix returns the prefix of the names of the mocks of the service. If the
+// config does not set it for the service, its package or the defaults, it
+// returns prefix.
+func (c *Config) Prefix(service *protogen.Service, prefix string) string {
+	if p := c.service(service).Prefix; p != nil {
+		return *p
+	}
+	return prefix
+}
+
+// Lenient reports whether the mock of the method is lenient. If the config does
+// not set it for the method, its service, package or the defaults, it returns
+// the lenient option of the mocker.
+func (c *Config) Lenient(method *protogen.Method, lenient bool) bool {
+	settings := c.service(method.Parent)
+	if c != nil {
+		settings.override(c.Methods[string(method.Desc.FullName())])
+	}
+	if settings.Lenient != nil {
+		return *settings.Lenient
+	}
+	return lenient
+}
+
+// override overrides the settings with the set settings of o.
+func (s *Settings) override(o Settings) {
+	if o.Lenient != nil {
+		s.Lenient = o.Lenient
+	}
+	if o.Skip != nil {
+		s.Skip = o.Skip
+	}
+	if o.Prefix != nil {
+		s.Prefix = o.Prefix
+	}
+}
+
+// filter returns the file without the skipped services.
+func (c *Config) filter(file *protogen.File) *protogen.File {
+	var services []*protogen.Service
+	for _, service := range file.Services {
+		if !c.Skip(service) {
+			services = append(services, service)
+		}
+	}
+	if len(services) == len(file.Services) {
+		return file
+	}
+	filtered := *file
+	filtered.Services = services
+	return &filtered
+}
+
+// configureFile returns the file without the skipped services and the mocker,
+// which uses the config.
+func (c *Config) configureFile(file *protogen.File, mocker Mocker) (*protogen.File, Mocker) {
+	if c == nil {
+		return file, mocker
+	}
+	return c.filter(file), c.mocker(mocker)
+}
+
+// configurePackage returns the package without the skipped services and the
+// mocker, which uses the config.
+func (c *Config) configurePackage(pkg *Package, mocker Mocker) (*Package, Mocker) {
+	if c == nil {
+		return pkg, mocker
+	}
+	filtered := &Package{Output: pkg.Output, Files: make([]*protogen.File, len(pkg.Files))}
+	for i, file := range pkg.Files {
+		filtered.Files[i] = c.filter(file)
+	}
+	return filtered, c.mocker(mocker)
+}
+
+// mocker returns the mocker, which uses the config, if it supports a config.
+func (c *Config) mocker(mocker Mocker) Mocker {
+	if m, ok := mocker.(ConfigMocker); ok {
+		return m.WithConfig(c)
+	}
+	return mocker
+}
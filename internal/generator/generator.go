@@ -2,6 +2,8 @@
 
 import (
 	"fmt"
+	"go/build/constraint"
+	"path"
 	"runtime/debug"
 	"strings"
 
@@ -12,18 +14,236 @@
 
 const FilenameSuffix = "_grpc_mock.pb.go"
 
+// ExampleFilenameSuffix is the suffix of the files containing the examples of the mocks.
+const ExampleFilenameSuffix = "_grpc_mock_example_test.go"
+
+const (
+	// LayoutFile generates the mocks of each .proto file to a separate file.
+	LayoutFile = "file"
+	// LayoutPackage generates the mocks of all .proto files of a Go package to a single file.
+	LayoutPackage = "package"
+)
+
+// Build configures the builds, which compile the generated files, so the mocks
+// and the imports of their frameworks are kept out of production binaries.
+type Build struct {
+	// TestFile generates the mocks to _test.go files, which are only compiled by go test.
+	TestFile bool
+	// Constraint is the expression of a //go:build line of the generated files.
+	Constraint string
+}
+
+// Validate returns an error, if the constraint is not a valid build constraint.
+func (b Build) Validate() error {
+	if b.Constraint == "" {
+		return nil
+	}
+	if _, err := constraint.Parse("//go:build " + b.Constraint); err != nil {
+		return fmt.Errorf("protoc-gen-go-grpcmock: invalid build constraint %q: %w", b.Constraint, err)
+	}
+	return nil
+}
+
+// Filename returns the name of the generated file for the filename of an output.
+func (b Build) Filename(filename string) string {
+	if b.TestFile {
+		return strings.TrimSuffix(filename, ".go") + "_test.go"
+	}
+	return filename
+}
+
 // Mocker implements the mock interface for .proto file.
 type Mocker interface {
 	Name() string
 	Mock(g *protogen.GeneratedFile, file *protogen.File)
 }
 
-func GenerateFile(version string, gen *protogen.Plugin, file *protogen.File, mocker Mocker) *protogen.GeneratedFile {
+// ExampleMocker is implemented by mockers, which generate runnable examples of
+// their mocks. Example reports false, if the mocker does not support examples
+// with its options.
+type ExampleMocker interface {
+	Mocker
+	Example(g *protogen.GeneratedFile, file *protogen.File) bool
+}
+
+// PackageMocker is implemented by mockers, which generate the mocks of several
+// .proto files to a single file. Unlike calling Mock for each file, MockPackage
+// generates the declarations shared by the files only once, for example the
+// matchers of messages used by the services of several files.
+type PackageMocker interface {
+	Mocker
+	MockPackage(g *protogen.GeneratedFile, files []*protogen.File)
+}
+
+// Output is the Go file, the mocks of a .proto file are generated to.
+type Output struct {
+	Filename    string
+	PackageName protogen.GoPackageName
+	ImportPath  protogen.GoImportPath
+}
+
+// OutputMocker is implemented by mockers, which may generate the mocks
+// to another Go package than the one of the .proto file.
+type OutputMocker interface {
+	Mocker
+	Output(file *protogen.File) Output
+}
+
+// DefaultOutput returns the output next to the code generated by protoc-gen-go.
+func DefaultOutput(file *protogen.File) Output {
+	return Output{
+		Filename:    file.GeneratedFilenamePrefix + FilenameSuffix,
+		PackageName: file.GoPackageName,
+		ImportPath:  file.GoImportPath,
+	}
+}
+
+// FileOutput returns the output of the mocks of the file.
+func FileOutput(file *protogen.File, mocker Mocker) Output {
+	if m, ok := mocker.(OutputMocker); ok {
+		return m.Output(file)
+	}
+	return DefaultOutput(file)
+}
+
+// Package is a Go package, the mocks of several .proto files are generated to
+// with LayoutPackage.
+type Package struct {
+	Output
+	Files []*protogen.File
+}
+
+// Packages groups the files by the Go package of their mocks. The packages and
+// their files are in the order of the files. The mocks of each package are
+// generated to a file named after the package in the directory of the mocks
+// of its first file.
+func Packages(files []*protogen.File, mocker Mocker) []*Package {
+	var pkgs []*Package
+	byImportPath := make(map[protogen.GoImportPath]*Package)
+	for _, file := range files {
+		output := FileOutput(file, mocker)
+		pkg, ok := byImportPath[output.ImportPath]
+		if !ok {
+			output.Filename = path.Join(path.Dir(output.Filename), string(output.PackageName)+FilenameSuffix)
+			pkg = &Package{Output: output}
+			byImportPath[output.ImportPath] = pkg
+			pkgs = append(pkgs, pkg)
+		}
+		pkg.Files = append(pkg.Files, file)
+	}
+	return pkgs
+}
+
+// GenerateFile generates the mocks of the file. The config overrides the options
+// of the mocks per service and method and skips services. It returns nil, if the
+// file contains no services or all its services are skipped.
+func GenerateFile(version string, gen *protogen.Plugin, file *protogen.File, mocker Mocker, build Build, config *Config) *protogen.GeneratedFile {
+	file, mocker = config.configureFile(file, mocker)
+	if len(file.Services) == 0 {
+		return nil
+	}
+	output := FileOutput(file, mocker)
+	g := gen.NewGeneratedFile(build.Filename(output.Filename), output.ImportPath)
+	generateHeader(g, version, gen, []*protogen.File{file}, mocker, output, build)
+
+	mocker.Mock(g, file)
+
+	return g
+}
+
+// GeneratePackageFile generates the mocks of all files of the package to a
+// single file like GenerateFile. It returns nil, if none of the files contains a
+// service, which is not skipped.
+func GeneratePackageFile(version string, gen *protogen.Plugin, pkg *Package, mocker Mocker, build Build, config *Config) *protogen.GeneratedFile {
+	pkg, mocker = config.configurePackage(pkg, mocker)
+	if !hasServices(pkg.Files) {
+		return nil
+	}
+	g := gen.NewGeneratedFile(build.Filename(pkg.Filename), pkg.ImportPath)
+	generateHeader(g, version, gen, pkg.Files, mocker, pkg.Output, build)
+
+	if m, ok := mocker.(PackageMocker); ok {
+		m.MockPackage(g, pkg.Files)
+	} else {
+		for _, file := range pkg.Files {
+			mocker.Mock(g, file)
+		}
+	}
+
+	return g
+}
+
+// ExampleFilename returns the name of the file containing the examples of the mocks of the file.
+func ExampleFilename(file *protogen.File, mocker Mocker) string {
+	return exampleFilename(FileOutput(file, mocker).Filename)
+}
+
+// ExampleFilename returns the name of the file containing the examples of the mocks of the package.
+func (pkg *Package) ExampleFilename() string {
+	return exampleFilename(pkg.Filename)
+}
+
+func exampleFilename(filename string) string {
+	return strings.TrimSuffix(filename, FilenameSuffix) + ExampleFilenameSuffix
+}
+
+// GenerateExampleFile generates the runnable examples of the mocks of the file
+// to a test file next to the mocks. It returns nil, if the mocker does not
+// generate examples.
+func GenerateExampleFile(version string, gen *protogen.Plugin, file *protogen.File, mocker Mocker, build Build, config *Config) *protogen.GeneratedFile {
+	file, mocker = config.configureFile(file, mocker)
 	if len(file.Services) == 0 {
 		return nil
 	}
-	filename := file.GeneratedFilenamePrefix + FilenameSuffix
-	g := gen.NewGeneratedFile(filename, file.GoImportPath)
+	output := FileOutput(file, mocker)
+	return generateExamples(version, gen, []*protogen.File{file}, mocker, output, ExampleFilename(file, mocker), build)
+}
+
+// GeneratePackageExampleFile generates the runnable examples of the mocks of
+// all files of the package to a test file next to the mocks. It returns nil, if
+// the mocker does not generate examples.
+func GeneratePackageExampleFile(version string, gen *protogen.Plugin, pkg *Package, mocker Mocker, build Build, config *Config) *protogen.GeneratedFile {
+	pkg, mocker = config.configurePackage(pkg, mocker)
+	if !hasServices(pkg.Files) {
+		return nil
+	}
+	return generateExamples(version, gen, pkg.Files, mocker, pkg.Output, pkg.ExampleFilename(), build)
+}
+
+// generateExamples generates the examples of the files. The examples are test
+// files anyway, but share the build constraint of the mocks, which they use.
+func generateExamples(version string, gen *protogen.Plugin, files []*protogen.File, mocker Mocker, output Output, filename string, build Build) *protogen.GeneratedFile {
+	m, ok := mocker.(ExampleMocker)
+	if !ok {
+		return nil
+	}
+	g := gen.NewGeneratedFile(filename, output.ImportPath)
+	generateHeader(g, version, gen, files, mocker, output, build)
+
+	for _, file := range files {
+		if !m.Example(g, file) {
+			g.Skip()
+			return nil
+		}
+	}
+
+	return g
+}
+
+func hasServices(files []*protogen.File) bool {
+	for _, file := range files {
+		if len(file.Services) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func generateHeader(g *protogen.GeneratedFile, version string, gen *protogen.Plugin, files []*protogen.File, mocker Mocker, output Output, build Build) {
+	if build.Constraint != "" {
+		g.P("//go:build ", build.Constraint)
+		g.P()
+	}
 	g.P("// Code generated by protoc-gen-go-grpcmock. DO NOT EDIT.")
 	g.P("// versions:")
 	g.P("// - ", fmt.Sprintf("%-23s", "protoc-gen-go-grpcmock"), version)
@@ -33,18 +253,24 @@ func GenerateFile(version string, gen *protogen.Plugin, file *protogen.File, moc
 	} else {
 		g.P("// - ", fmt.Sprintf("%-23s", mocker.Name()), "unknown")
 	}
-	if file.Proto.GetOptions().GetDeprecated() {
-		g.P("// ", file.Desc.Path(), " is a deprecated file.")
-	} else {
-		g.P("// source: ", file.Desc.Path())
+	switch {
+	case len(files) > 1:
+		g.P("// sources:")
+		for _, file := range files {
+			if file.Proto.GetOptions().GetDeprecated() {
+				g.P("// - ", file.Desc.Path(), " (deprecated)")
+			} else {
+				g.P("// - ", file.Desc.Path())
+			}
+		}
+	case files[0].Proto.GetOptions().GetDeprecated():
+		g.P("// ", files[0].Desc.Path(), " is a deprecated file.")
+	default:
+		g.P("// source: ", files[0].Desc.Path())
 	}
 	g.P()
-	g.P("package ", file.GoPackageName)
+	g.P("package ", output.PackageName)
 	g.P()
-
-	mocker.Mock(g, file)
-
-	return g
 }
 
 func module(name string) *debug.Module {
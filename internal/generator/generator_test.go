@@ -0,0 +1,756 @@
+package generator_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build/constraint"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	editions "github.com/lovoo/protoc-gen-go-grpcmock/examples/editions/testify"
+	helloworld "github.com/lovoo/protoc-gen-go-grpcmock/examples/helloworld/testify"
+	library "github.com/lovoo/protoc-gen-go-grpcmock/examples/library/fake"
+	routeguide "github.com/lovoo/protoc-gen-go-grpcmock/examples/routeguide/testify"
+	"github.com/lovoo/protoc-gen-go-grpcmock/internal/framework"
+	"github.com/lovoo/protoc-gen-go-grpcmock/internal/generator"
+)
+
+// options are the combinations of options used to generate the examples.
+var options = []framework.Options{
+	{},
+	{Gomega: true, TestingTB: true, Suite: true},
+	{Suite: true},
+	{Lenient: true, TestingTB: true},
+}
+
+var examples = []struct {
+	file       protoreflect.FileDescriptor
+	frameworks []string
+	dir        string
+	target     string
+}{
+	{helloworld.File_helloworld_proto, []string{"testify", "pegomock"}, "../../examples/helloworld", framework.TargetGRPC},
+	{routeguide.File_route_guide_proto, []string{"testify", "pegomock"}, "../../examples/routeguide", framework.TargetGRPC},
+	{library.File_library_proto, []string{"fake"}, "../../examples/library", framework.TargetGRPC},
+	{editions.File_editions_proto, []string{"testify", "pegomock"}, "../../examples/editions", framework.TargetGRPC},
+	{routeguide.File_route_guide_proto, []string{"testify", "pegomock"}, "../../examples/routeguide/connect", framework.TargetConnect},
+	{helloworld.File_helloworld_proto, []string{"testify", "pegomock"}, "../../examples/helloworld/twirp", framework.TargetTwirp},
+}
+
+// exampleParam returns the parameter and the directory of the generated code of the example.
+// The connect examples map the .proto file to the package of the example, which contains
+// the connect package. The twirp examples map it to a package in the directory of the example.
+func exampleParam(file protoreflect.FileDescriptor, dir, name, target string) (string, string) {
+	importPath := "github.com/lovoo/protoc-gen-go-grpcmock/examples/" + strings.TrimPrefix(filepath.ToSlash(dir), "../../examples/") + "/" + name
+	pkg := string(file.Package())
+	switch target {
+	case framework.TargetConnect:
+		return fmt.Sprintf("M%s=%s;%s", file.Path(), importPath, pkg), filepath.Join(dir, name, pkg+framework.ConnectPackageSuffix)
+	case framework.TargetTwirp:
+		return fmt.Sprintf("M%s=%s/%s;%s", file.Path(), importPath, pkg, pkg), filepath.Join(dir, name, pkg)
+	default:
+		return "", filepath.Join(dir, name)
+	}
+}
+
+// mocker creates the mocker of the test framework with the options and skips the
+// test, if the test framework does not support the options for the target.
+func mocker(t *testing.T, name string, opts framework.Options) generator.Mocker {
+	t.Helper()
+	m, err := framework.Mocker(name, opts)
+	if err != nil && strings.Contains(err.Error(), "unsupported option") {
+		t.Skip(err)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+// TestMockerUnsupportedOptions checks that the options are rejected for the
+// mocks, which do not support them.
+func TestMockerUnsupportedOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts framework.Options
+	}{
+		{"pegomock", framework.Options{Lenient: true}},
+		{"testify", framework.Options{Lenient: true, Target: framework.TargetConnect}},
+		{"testify", framework.Options{Lenient: true, Target: framework.TargetTwirp}},
+		{"pegomock", framework.Options{Suite: true}},
+		{"fake", framework.Options{Suite: true}},
+		{"fake", framework.Options{Lenient: true}},
+		{"fake", framework.Options{Gomega: true}},
+		{"fake", framework.Options{TestingTB: true}},
+		{"testify", framework.Options{Suite: true, Target: framework.TargetConnect}},
+		{"testify", framework.Options{Suite: true, Target: framework.TargetTwirp}},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%s/%+v", test.name, test.opts), func(t *testing.T) {
+			if _, err := framework.Mocker(test.name, test.opts); err == nil || !strings.Contains(err.Error(), "unsupported option") {
+				t.Errorf("Mocker() error = %v, want unsupported option", err)
+			}
+		})
+	}
+}
+
+// TestGenerateFileTypeChecks generates the mocks and their runnable examples of all
+// examples and type-checks them together with the code generated by protoc-gen-go
+// and protoc-gen-go-grpc. The generated compile-time assertions ensure that the mocks implement the
+// generated interfaces.
+func TestGenerateFileTypeChecks(t *testing.T) {
+	// The editions example sets features on its service and a method, which
+	// must not change the generated mocks.
+	type featureOptions interface {
+		GetFeatures() *descriptorpb.FeatureSet
+	}
+	service := editions.File_editions_proto.Services().Get(0)
+	for _, opts := range []featureOptions{
+		service.Options().(*descriptorpb.ServiceOptions),
+		service.Methods().ByName("WatchItems").Options().(*descriptorpb.MethodOptions),
+	} {
+		if !proto.HasExtension(opts.GetFeatures(), editions.E_Inventory) {
+			t.Fatalf("%v: missing inventory features", opts)
+		}
+	}
+
+	fset := token.NewFileSet()
+	imp := importer.ForCompiler(fset, "source", nil)
+
+	for _, example := range examples {
+		for _, name := range example.frameworks {
+			for _, opts := range options {
+				opts.Target = example.target
+				t.Run(fmt.Sprintf("%s/%s/%+v", example.file.Path(), name, opts), func(t *testing.T) {
+					m := mocker(t, name, opts)
+
+					param, dir := exampleParam(example.file, example.dir, name, example.target)
+					content := generate(t, example.file, m, param)
+
+					files := parseDir(t, fset, dir)
+					f, err := parser.ParseFile(fset, filepath.Join(dir, "generated"+generator.FilenameSuffix), content, 0)
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					files = append(files, f)
+					if content := generateExample(t, example.file, m, param); content != nil {
+						f, err := parser.ParseFile(fset, filepath.Join(dir, "generated"+generator.ExampleFilenameSuffix), content, 0)
+						if err != nil {
+							t.Fatal(err)
+						}
+						files = append(files, f)
+					}
+
+					conf := types.Config{Importer: imp}
+					if _, err := conf.Check(files[0].Name.Name, fset, files, nil); err != nil {
+						t.Fatal(err)
+					}
+				})
+			}
+		}
+	}
+}
+
+// TestGenerateFileMultipleServices generates the mocks of a file with multiple
+// services, which must share the imports of the generated file.
+func TestGenerateFileMultipleServices(t *testing.T) {
+	method := &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String("Ping"),
+		InputType:  proto.String(".google.protobuf.Empty"),
+		OutputType: proto.String(".google.protobuf.Empty"),
+	}
+	stream := &descriptorpb.MethodDescriptorProto{
+		Name:            proto.String("Watch"),
+		InputType:       proto.String(".google.protobuf.Empty"),
+		OutputType:      proto.String(".google.protobuf.Empty"),
+		ServerStreaming: proto.Bool(true),
+	}
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:       proto.String("multi.proto"),
+		Package:    proto.String("multi"),
+		Dependency: []string{"google/protobuf/empty.proto"},
+		Syntax:     proto.String("proto3"),
+		Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/multi")},
+		Service: []*descriptorpb.ServiceDescriptorProto{
+			{Name: proto.String("Foo"), Method: []*descriptorpb.MethodDescriptorProto{method, stream}},
+			{Name: proto.String("Bar"), Method: []*descriptorpb.MethodDescriptorProto{method, stream}},
+		},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"testify", "pegomock"} {
+		for _, opts := range options {
+			t.Run(fmt.Sprintf("%s/%+v", name, opts), func(t *testing.T) {
+				m := mocker(t, name, opts)
+
+				f, err := parser.ParseFile(token.NewFileSet(), "", generate(t, fd, m, ""), parser.ImportsOnly)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				seen := make(map[string]bool)
+				for _, spec := range f.Imports {
+					if seen[spec.Path.Value] {
+						t.Errorf("duplicate import %s", spec.Path.Value)
+					}
+					seen[spec.Path.Value] = true
+				}
+			})
+		}
+	}
+}
+
+// TestGeneratePackageFile generates the mocks of two files of the same Go package,
+// whose services share messages and method names, to a single file, which must
+// not declare any name twice.
+func TestGeneratePackageFile(t *testing.T) {
+	fileOptions := &descriptorpb.FileOptions{GoPackage: proto.String("example.com/multi")}
+	common, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("common.proto"),
+		Package: proto.String("multi"),
+		Syntax:  proto.String("proto3"),
+		Options: fileOptions,
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("Item"), Field: []*descriptorpb.FieldDescriptorProto{{
+				Name: proto.String("name"), Number: proto.Int32(1), JsonName: proto.String("name"),
+				Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(), Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			}}},
+		},
+	}, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := new(protoregistry.Files)
+	if err := files.RegisterFile(common); err != nil {
+		t.Fatal(err)
+	}
+
+	var fds []protoreflect.FileDescriptor
+	for _, name := range []string{"foo", "bar"} {
+		fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+			Name:       proto.String(name + ".proto"),
+			Package:    proto.String("multi"),
+			Dependency: []string{"common.proto"},
+			Syntax:     proto.String("proto3"),
+			Options:    fileOptions,
+			Service: []*descriptorpb.ServiceDescriptorProto{{
+				Name: proto.String(strings.ToUpper(name[:1]) + name[1:]),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{Name: proto.String("Get"), InputType: proto.String(".multi.Item"), OutputType: proto.String(".multi.Item")},
+					{Name: proto.String("Watch"), InputType: proto.String(".multi.Item"), OutputType: proto.String(".multi.Item"), ServerStreaming: proto.Bool(true)},
+				},
+			}},
+		}, files)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fds = append(fds, fd)
+	}
+
+	for _, name := range []string{"testify", "pegomock"} {
+		for _, opts := range options {
+			t.Run(fmt.Sprintf("%s/%+v", name, opts), func(t *testing.T) {
+				m := mocker(t, name, opts)
+
+				req := &pluginpb.CodeGeneratorRequest{
+					FileToGenerate: []string{common.Path(), fds[0].Path(), fds[1].Path()},
+					ProtoFile: []*descriptorpb.FileDescriptorProto{
+						protodesc.ToFileDescriptorProto(common), protodesc.ToFileDescriptorProto(fds[0]), protodesc.ToFileDescriptorProto(fds[1]),
+					},
+				}
+				gen, err := protogen.Options{}.New(req)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				pkgs := generator.Packages(gen.Files, m)
+				if len(pkgs) != 1 {
+					t.Fatalf("got %d packages, want 1", len(pkgs))
+				}
+				if want := "example.com/multi/multi" + generator.FilenameSuffix; pkgs[0].Filename != want {
+					t.Errorf("got filename %s, want %s", pkgs[0].Filename, want)
+				}
+				content, err := generator.GeneratePackageFile("test", gen, pkgs[0], m, generator.Build{}, nil).Content()
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				f, err := parser.ParseFile(token.NewFileSet(), "", content, 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+				seen := make(map[string]bool)
+				for _, decl := range f.Decls {
+					var names []string
+					switch decl := decl.(type) {
+					case *ast.FuncDecl:
+						if decl.Recv == nil {
+							names = append(names, decl.Name.Name)
+						}
+					case *ast.GenDecl:
+						for _, spec := range decl.Specs {
+							if spec, ok := spec.(*ast.TypeSpec); ok {
+								names = append(names, spec.Name.Name)
+							}
+						}
+					}
+					for _, name := range names {
+						if seen[name] {
+							t.Errorf("duplicate declaration %s", name)
+						}
+						seen[name] = true
+					}
+				}
+				for _, name := range []string{"NewMockFooClient", "NewMockBarClient", "CaptureItem"} {
+					if !seen[name] {
+						t.Errorf("missing declaration %s", name)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestGenerateFileDeterministic generates the mocks of all examples repeatedly
+// and compares the content of the generated files.
+func TestGenerateFileDeterministic(t *testing.T) {
+	for _, example := range examples {
+		for _, name := range example.frameworks {
+			t.Run(fmt.Sprintf("%s/%s", example.file.Path(), name), func(t *testing.T) {
+				m, err := framework.Mocker(name, framework.Options{Target: example.target, Gomega: name != "fake"})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				param, _ := exampleParam(example.file, example.dir, name, example.target)
+				want := generate(t, example.file, m, param)
+				for i := 0; i < 10; i++ {
+					if got := generate(t, example.file, m, param); !bytes.Equal(got, want) {
+						t.Fatalf("run %d generated different content", i)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestGenerateFileBuild generates the mocks and the examples to test files with a
+// build constraint.
+func TestGenerateFileBuild(t *testing.T) {
+	m, err := framework.Mocker("testify", framework.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	build := generator.Build{TestFile: true, Constraint: "mock && !prod"}
+	if err := build.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	gen := newPlugin(t, helloworld.File_helloworld_proto, "paths=source_relative")
+	file := gen.FilesByPath[helloworld.File_helloworld_proto.Path()]
+	generator.GenerateFile("test", gen, file, m, build, nil)
+	generator.GenerateExampleFile("test", gen, file, m, build, nil)
+
+	want := map[string]bool{"helloworld_grpc_mock.pb_test.go": true, "helloworld" + generator.ExampleFilenameSuffix: true}
+	res := gen.Response()
+	if res.Error != nil {
+		t.Fatal(res.GetError())
+	}
+	for _, f := range res.File {
+		if !want[f.GetName()] {
+			t.Errorf("unexpected file %s", f.GetName())
+			continue
+		}
+		delete(want, f.GetName())
+
+		expr, err := constraint.Parse(strings.SplitN(f.GetContent(), "\n", 2)[0])
+		if err != nil {
+			t.Fatalf("%s: %v", f.GetName(), err)
+		}
+		tags := map[string]bool{"mock": true}
+		if !expr.Eval(func(tag string) bool { return tags[tag] }) {
+			t.Errorf("%s: constraint %s is not satisfied by the tag mock", f.GetName(), expr)
+		}
+		tags["prod"] = true
+		if expr.Eval(func(tag string) bool { return tags[tag] }) {
+			t.Errorf("%s: constraint %s is satisfied by the tag prod", f.GetName(), expr)
+		}
+	}
+	for name := range want {
+		t.Errorf("missing file %s", name)
+	}
+
+	if err := (generator.Build{Constraint: "mock &&"}).Validate(); err == nil {
+		t.Error("expected an invalid build constraint")
+	}
+}
+
+// TestGenerateFileTestingTB checks that testing_tb adds the constructors
+// accepting a testing.TB next to the constructors without arguments.
+func TestGenerateFileTestingTB(t *testing.T) {
+	for name, ctors := range map[string][]string{
+		"testify": {
+			"func NewMockRouteGuideClient() *MockRouteGuideClient",
+			"func NewMockRouteGuideClientT(t testing.TB) *MockRouteGuideClient",
+			"func NewRouteGuide_RouteChatClientScript() *",
+			"func NewRouteGuide_RouteChatClientScriptT(t testing.TB) *",
+		},
+		"pegomock": {
+			"func NewMockRouteGuideClient(options ...pegomock.Option) *MockRouteGuideClient",
+			"func NewMockRouteGuideClientT(t testing.TB, options ...pegomock.Option) *MockRouteGuideClient",
+			"func NewMockRouteGuide_RouteChatClient(options ...pegomock.Option) *MockRouteGuide_RouteChatClient",
+			"func NewMockRouteGuide_RouteChatClientT(t testing.TB, options ...pegomock.Option) *MockRouteGuide_RouteChatClient",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := mocker(t, name, framework.Options{TestingTB: true})
+			gen := newPlugin(t, routeguide.File_route_guide_proto, "")
+			content, err := generator.GenerateFile("test", gen, gen.FilesByPath[routeguide.File_route_guide_proto.Path()], m, generator.Build{}, nil).Content()
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, ctor := range ctors {
+				if !strings.Contains(string(content), ctor) {
+					t.Errorf("missing constructor %s", ctor)
+				}
+			}
+		})
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	for _, tt := range []struct {
+		name, data string
+		err        error
+	}{
+		{"empty", "", nil},
+		{"valid", "defaults: {lenient: true, prefix: Stub}\npackages: {helloworld: {skip: true}}\nservices: {routeguide.RouteGuide: {lenient: false, prefix: Fake}}\nmethods: {routeguide.RouteGuide.GetFeature: {lenient: true}}", nil},
+		{"invalid name", "services: {route-guide.RouteGuide: {}}", generator.ErrInvalidName},
+		{"method without service", "methods: {GetFeature: {lenient: true}}", generator.ErrInvalidMethodName},
+		{"skipped method", "methods: {routeguide.RouteGuide.GetFeature: {skip: true}}", generator.ErrSkippedMethod},
+		{"method prefix", "methods: {routeguide.RouteGuide.GetFeature: {prefix: Stub}}", generator.ErrMethodPrefix},
+		{"unexported prefix", "services: {routeguide.RouteGuide: {prefix: stub}}", generator.ErrInvalidPrefix},
+		{"empty prefix", "defaults: {prefix: ''}", generator.ErrInvalidPrefix},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := generator.ParseConfig([]byte(tt.data))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseConfig() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+
+	// Unknown fields are rejected by the YAML decoder.
+	if _, err := generator.ParseConfig([]byte("defaults: {strict: true}")); err == nil || !strings.Contains(err.Error(), "field strict not found") {
+		t.Fatalf("ParseConfig() error = %v, want unknown field", err)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	gen := newPlugin(t, routeguide.File_route_guide_proto, "")
+	for _, tt := range []struct {
+		name, data string
+		err        error
+	}{
+		{"declared", "packages: {routeguide: {}}\nservices: {routeguide.RouteGuide: {}}\nmethods: {routeguide.RouteGuide.GetFeature: {}}", nil},
+		{"unknown package", "packages: {route_guide: {skip: true}}", generator.ErrUnknownPackage},
+		{"unknown service", "services: {routeguide.RouteGide: {lenient: true}}", generator.ErrUnknownService},
+		{"unknown method", "methods: {routeguide.RouteGuide.GetFeatures: {lenient: true}}", generator.ErrUnknownMethod},
+		{"service as package", "packages: {routeguide.RouteGuide: {skip: true}}", generator.ErrUnknownPackage},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := generator.ParseConfig([]byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := config.Validate(gen.Files); !errors.Is(err, tt.err) {
+				t.Fatalf("Validate() error = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+// TestValidateConfig checks that a config, which enables lenient mocks or sets
+// the prefix of the mocks, is rejected for the mocks, which do not support them.
+func TestValidateConfig(t *testing.T) {
+	lenient, err := generator.ParseConfig([]byte("services: {routeguide.RouteGuide: {lenient: true}}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	strict, err := generator.ParseConfig([]byte("defaults: {lenient: false}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix, err := generator.ParseConfig([]byte("packages: {routeguide: {prefix: Stub}}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		name   string
+		opts   framework.Options
+		config *generator.Config
+		valid  bool
+	}{
+		{"testify", framework.Options{}, lenient, true},
+		{"testify", framework.Options{Target: framework.TargetConnect}, lenient, false},
+		{"testify", framework.Options{Target: framework.TargetTwirp}, lenient, false},
+		{"pegomock", framework.Options{}, lenient, false},
+		{"pegomock", framework.Options{}, strict, true},
+		{"pegomock", framework.Options{}, nil, true},
+		{"testify", framework.Options{}, prefix, true},
+		{"testify", framework.Options{Target: framework.TargetConnect}, prefix, false},
+		{"pegomock", framework.Options{}, prefix, false},
+		{"fake", framework.Options{}, prefix, false},
+	} {
+		t.Run(fmt.Sprintf("%s/%+v", tt.name, tt.opts), func(t *testing.T) {
+			err := framework.ValidateConfig(tt.name, tt.opts, tt.config)
+			if tt.valid && err != nil {
+				t.Fatal(err)
+			}
+			if !tt.valid && (err == nil || !strings.Contains(err.Error(), "unsupported option")) {
+				t.Fatalf("ValidateConfig() error = %v, want unsupported option", err)
+			}
+		})
+	}
+}
+
+// TestGenerateFileConfig generates the mocks with the settings of a config.
+func TestGenerateFileConfig(t *testing.T) {
+	m, err := framework.Mocker("testify", framework.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := generator.ParseConfig([]byte("methods: {routeguide.RouteGuide.GetFeature: {lenient: true}}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gen := newPlugin(t, routeguide.File_route_guide_proto, "")
+	content, err := generator.GenerateFile("test", gen, gen.FilesByPath[routeguide.File_route_guide_proto.Path()], m, generator.Build{}, config).Content()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls int
+	for _, line := range strings.Split(string(content), "\n") {
+		lenient := strings.Contains(line, "testifymock.LenientCalled(")
+		if strings.Contains(line, `"GetFeature"`) && strings.Contains(line, "Called(") {
+			calls++
+			if !lenient {
+				t.Errorf("GetFeature is not lenient: %s", line)
+			}
+		}
+		if strings.Contains(line, `"ListFeatures"`) && lenient {
+			t.Errorf("ListFeatures is lenient: %s", line)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("got %d calls of GetFeature, want 2", calls)
+	}
+
+	for _, data := range []string{"packages: {routeguide: {skip: true}}", "services: {routeguide.RouteGuide: {skip: true}}"} {
+		config, err := generator.ParseConfig([]byte(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		gen := newPlugin(t, routeguide.File_route_guide_proto, "")
+		if g := generator.GenerateFile("test", gen, gen.FilesByPath[routeguide.File_route_guide_proto.Path()], m, generator.Build{}, config); g != nil {
+			t.Errorf("%s: expected no mocks", data)
+		}
+	}
+}
+
+// TestGenerateFilePrefix generates the mocks, the suite and the examples with the
+// prefix of a config and type-checks them.
+func TestGenerateFilePrefix(t *testing.T) {
+	m, err := framework.Mocker("testify", framework.Options{Suite: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := generator.ParseConfig([]byte("services: {routeguide.RouteGuide: {prefix: Stub}}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gen := newPlugin(t, routeguide.File_route_guide_proto, "")
+	file := gen.FilesByPath[routeguide.File_route_guide_proto.Path()]
+	content, err := generator.GenerateFile("test", gen, file, m, generator.Build{}, config).Content()
+	if err != nil {
+		t.Fatal(err)
+	}
+	example, err := generator.GenerateExampleFile("test", gen, file, m, generator.Build{}, config).Content()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, decl := range []string{"type StubRouteGuideClient struct", "func NewStubRouteGuide_RouteChatServer()", "type RouteGuideStubSuite struct"} {
+		if !bytes.Contains(content, []byte(decl)) {
+			t.Errorf("missing declaration %s", decl)
+		}
+	}
+	if bytes.Contains(content, []byte("MockRouteGuide")) {
+		t.Error("the mocks are named with the default prefix")
+	}
+
+	fset := token.NewFileSet()
+	files := parseDir(t, fset, "../../examples/routeguide/testify")
+	for name, src := range map[string][]byte{"generated" + generator.FilenameSuffix: content, "generated" + generator.ExampleFilenameSuffix: example} {
+		f, err := parser.ParseFile(fset, filepath.Join("../../examples/routeguide/testify", name), src, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check(files[0].Name.Name, fset, files, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestCheckFile(t *testing.T) {
+	m, err := framework.Mocker("testify", framework.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	filename := "helloworld" + generator.FilenameSuffix
+
+	// Missing files fail.
+	err = generator.CheckFile(dir, filename, generateFile(t, helloworld.File_helloworld_proto, m, ""))
+	if err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Fatalf("expected missing file, got %v", err)
+	}
+
+	// Up-to-date files succeed.
+	content := generate(t, helloworld.File_helloworld_proto, m, "")
+	if err := os.WriteFile(filepath.Join(dir, filename), content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	g := generateFile(t, helloworld.File_helloworld_proto, m, "")
+	if err := generator.CheckFile(dir, filename, g); err != nil {
+		t.Fatal(err)
+	}
+
+	// Stale files fail with a diff.
+	stale := bytes.Replace(content, []byte("MockGreeterClient struct"), []byte("MockGreeterClient2 struct"), 1)
+	if err := os.WriteFile(filepath.Join(dir, filename), stale, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	err = generator.CheckFile(dir, filename, generateFile(t, helloworld.File_helloworld_proto, m, ""))
+	if err == nil || !strings.Contains(err.Error(), "+type MockGreeterClient struct") {
+		t.Fatalf("expected diff, got %v", err)
+	}
+}
+
+// generate runs the generator on the file and returns the content of the generated file.
+func generate(t *testing.T, file protoreflect.FileDescriptor, m generator.Mocker, param string) []byte {
+	t.Helper()
+
+	content, err := generateFile(t, file, m, param).Content()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+// generateFile runs the generator on the file with the parameter and returns the generated file.
+func generateFile(t *testing.T, file protoreflect.FileDescriptor, m generator.Mocker, param string) *protogen.GeneratedFile {
+	t.Helper()
+
+	gen := newPlugin(t, file, param)
+	return generator.GenerateFile("test", gen, gen.FilesByPath[file.Path()], m, generator.Build{}, nil)
+}
+
+// generateExample runs the generator of the examples on the file with the parameter and
+// returns the content of the generated file or nil, if the mocker does not generate examples.
+func generateExample(t *testing.T, file protoreflect.FileDescriptor, m generator.Mocker, param string) []byte {
+	t.Helper()
+
+	gen := newPlugin(t, file, param)
+	g := generator.GenerateExampleFile("test", gen, gen.FilesByPath[file.Path()], m, generator.Build{}, nil)
+	if g == nil {
+		return nil
+	}
+	content, err := g.Content()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return content
+}
+
+// newPlugin returns the plugin generating the file with the parameter.
+func newPlugin(t *testing.T, file protoreflect.FileDescriptor, param string) *protogen.Plugin {
+	t.Helper()
+
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.Path()},
+		Parameter:      proto.String(param),
+		ProtoFile:      fileDescriptorProtos(file, make(map[string]bool)),
+	}
+	gen, err := protogen.Options{}.New(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return gen
+}
+
+// fileDescriptorProtos returns the file and its transitive imports in topological order.
+func fileDescriptorProtos(file protoreflect.FileDescriptor, seen map[string]bool) []*descriptorpb.FileDescriptorProto {
+	if seen[file.Path()] {
+		return nil
+	}
+	seen[file.Path()] = true
+
+	var files []*descriptorpb.FileDescriptorProto
+	for i := 0; i < file.Imports().Len(); i++ {
+		files = append(files, fileDescriptorProtos(file.Imports().Get(i).FileDescriptor, seen)...)
+	}
+	return append(files, protodesc.ToFileDescriptorProto(file))
+}
+
+// parseDir parses the code generated by protoc-gen-go, protoc-gen-go-grpc, protoc-gen-connect-go
+// and protoc-gen-twirp in dir.
+func parseDir(t *testing.T, fset *token.FileSet, dir string) []*ast.File {
+	t.Helper()
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var files []*ast.File
+	for _, path := range paths {
+		if strings.HasSuffix(path, generator.FilenameSuffix) || strings.HasSuffix(path, framework.ConnectFilenameSuffix) ||
+			strings.HasSuffix(path, framework.TwirpFilenameSuffix) || strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		t.Fatalf("no generated code found in %s", dir)
+	}
+	return files
+}